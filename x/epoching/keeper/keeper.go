@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -40,26 +41,44 @@ func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout tim
 	}
 }
 
-// GetNewActionID returns ID to be used for next epoch
-func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(NextEpochActionID)
+// PeekNextActionID returns the ID the next queued action will receive
+// without consuming it
+func (k Keeper) PeekNextActionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(NextEpochActionID)
 	if bz == nil {
-		// return default action ID to 1
 		return DefaultEpochActionID
 	}
-	id := sdk.BigEndianToUint64(bz)
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetNewActionID returns ID to be used for next epoch
+func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
+	id := k.PeekNextActionID(ctx)
 
 	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
+	ctx.KVStore(k.storeKey).Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
 
 	return id
 }
 
-// ActionStoreKey returns action store key from ID
+// ActionStoreKey returns action store key from ID. Both the epoch number and
+// the action ID are big endian encoded so that the actions are iterated in
+// epoch and then queueing order.
 func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
+	return append(EpochActionsPrefix(epochNumber), sdk.Uint64ToBigEndian(actionID)...)
+}
+
+// ParseActionStoreKey returns the epoch number and the action ID of an action
+// store key
+func ParseActionStoreKey(key []byte) (epochNumber int64, actionID uint64) {
+	key = key[len(EpochActionQueuePrefix):]
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:])
+}
+
+// EpochActionsPrefix returns the store prefix of the actions queued for an epoch
+func EpochActionsPrefix(epochNumber int64) []byte {
+	return append(EpochActionQueuePrefix, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
 }
 
 // QueueMsgForEpoch save the actions that need to be executed on next epoch
@@ -91,17 +110,19 @@ func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *c
 
 // GetEpochMsg gets a msg by ID
 func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
-	store := ctx.KVStore(k.storeKey)
+	action, _ := k.GetEpochActionByID(ctx, epochNumber, actionID)
+	return action
+}
 
-	bz := store.Get(ActionStoreKey(epochNumber, actionID))
+// GetEpochActionByID returns the action queued for the given epoch under
+// the given ID and whether it was found
+func (k Keeper) GetEpochActionByID(ctx sdk.Context, epochNumber int64, actionID uint64) (sdk.Msg, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(ActionStoreKey(epochNumber, actionID))
 	if bz == nil {
-		return nil
+		return nil, false
 	}
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
-
-	return action
+	return k.mustUnmarshalAction(epochNumber, actionID, bz), true
 }
 
 // GetEpochActions get all actions
@@ -111,15 +132,25 @@ func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var action sdk.Msg
-		bz := iterator.Value()
-		k.cdc.UnmarshalInterface(bz, &action)
-		actions = append(actions, action)
+		actions = append(actions, k.GetEpochActionByIterator(iterator))
 	}
 
 	return actions
 }
 
+// IterateEpochActions iterates over the actions queued for the given epoch
+// and calls cb with each decoded msg until cb returns true
+func (k Keeper) IterateEpochActions(ctx sdk.Context, epochNumber int64, cb func(msg sdk.Msg) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionsPrefix(epochNumber))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(k.GetEpochActionByIterator(iterator)) {
+			break
+		}
+	}
+}
+
 // GetEpochActionsIterator returns iterator for EpochActions
 func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
 	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
@@ -145,10 +176,17 @@ func (k Keeper) DeleteByKey(ctx sdk.Context, key []byte) {
 
 // GetEpochActionByIterator get action by iterator
 func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
-	bz := iterator.Value()
+	epochNumber, actionID := ParseActionStoreKey(iterator.Key())
+	return k.mustUnmarshalAction(epochNumber, actionID, iterator.Value())
+}
 
+// mustUnmarshalAction decodes a stored action and panics if the stored bytes
+// are not a valid msg, as the queue only holds msgs encoded by the keeper
+func (k Keeper) mustUnmarshalAction(epochNumber int64, actionID uint64, bz []byte) sdk.Msg {
 	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+	if err := k.cdc.UnmarshalInterface(bz, &action); err != nil {
+		panic(fmt.Sprintf("failed to decode action %d queued for epoch %d: %s", actionID, epochNumber, err))
+	}
 
 	return action
 }
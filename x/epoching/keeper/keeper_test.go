@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+)
+
+func createTestKeeper() (keeper.Keeper, sdk.Context) {
+	key := sdk.NewKVStoreKey("epoching")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	return keeper.NewKeeper(cdc, key, time.Second), ctx
+}
+
+func newTestMsg(amount int64) sdk.Msg {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	return banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+}
+
+func TestIterateEpochActions(t *testing.T) {
+	k, ctx := createTestKeeper()
+
+	msg1, msg2 := newTestMsg(1), newTestMsg(2)
+	k.QueueMsgForEpoch(ctx, 1, msg1)
+	k.QueueMsgForEpoch(ctx, 2, msg2)
+
+	var msgs []sdk.Msg
+	k.IterateEpochActions(ctx, 1, func(msg sdk.Msg) bool {
+		msgs = append(msgs, msg)
+		return false
+	})
+	require.Equal(t, []sdk.Msg{msg1}, msgs)
+
+	msgs = nil
+	k.IterateEpochActions(ctx, 3, func(msg sdk.Msg) bool {
+		msgs = append(msgs, msg)
+		return false
+	})
+	require.Empty(t, msgs)
+
+	// iteration stops once the callback returns true
+	count := 0
+	k.IterateEpochActions(ctx, 2, func(msg sdk.Msg) bool {
+		require.IsType(t, &banktypes.MsgSend{}, msg)
+		count++
+		return true
+	})
+	require.Equal(t, 1, count)
+}
+
+func TestGetNewActionID(t *testing.T) {
+	k, ctx := createTestKeeper()
+
+	require.Equal(t, uint64(keeper.DefaultEpochActionID), k.GetNewActionID(ctx))
+	require.Equal(t, uint64(keeper.DefaultEpochActionID+1), k.GetNewActionID(ctx))
+	require.Equal(t, uint64(keeper.DefaultEpochActionID+2), k.GetNewActionID(ctx))
+}
+
+func TestPeekNextActionID(t *testing.T) {
+	k, ctx := createTestKeeper()
+
+	// peeking does not consume an ID
+	require.Equal(t, uint64(keeper.DefaultEpochActionID), k.PeekNextActionID(ctx))
+	require.Equal(t, uint64(keeper.DefaultEpochActionID), k.PeekNextActionID(ctx))
+
+	for i := 0; i < 3; i++ {
+		next := k.PeekNextActionID(ctx)
+		k.QueueMsgForEpoch(ctx, 1, newTestMsg(int64(i+1)))
+		require.Equal(t, newTestMsg(int64(i+1)), k.GetEpochMsg(ctx, 1, next))
+		require.Equal(t, next+1, k.PeekNextActionID(ctx))
+	}
+
+	require.Equal(t, k.PeekNextActionID(ctx), k.GetNewActionID(ctx))
+}
+
+func TestGetEpochActionByID(t *testing.T) {
+	k, ctx := createTestKeeper()
+
+	msg1, msg2 := newTestMsg(1), newTestMsg(2)
+	id1 := k.PeekNextActionID(ctx)
+	k.QueueMsgForEpoch(ctx, 1, msg1)
+	id2 := k.PeekNextActionID(ctx)
+	k.QueueMsgForEpoch(ctx, 2, msg2)
+
+	action, found := k.GetEpochActionByID(ctx, 1, id1)
+	require.True(t, found)
+	require.Equal(t, msg1, action)
+
+	action, found = k.GetEpochActionByID(ctx, 2, id2)
+	require.True(t, found)
+	require.Equal(t, msg2, action)
+
+	// the ID is only looked up within the given epoch
+	action, found = k.GetEpochActionByID(ctx, 2, id1)
+	require.False(t, found)
+	require.Nil(t, action)
+
+	// dequeued actions are no longer found
+	k.DequeueEpochActions(ctx)
+	_, found = k.GetEpochActionByID(ctx, 1, id1)
+	require.False(t, found)
+	require.Nil(t, k.GetEpochMsg(ctx, 1, id1))
+}
+
+func TestCorruptEpochAction(t *testing.T) {
+	key := sdk.NewKVStoreKey("epoching")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	k := keeper.NewKeeper(simapp.MakeTestEncodingConfig().Codec, key, time.Second)
+
+	ctx.KVStore(key).Set(keeper.ActionStoreKey(3, 7), []byte{0xff})
+
+	epochNumber, actionID := keeper.ParseActionStoreKey(keeper.ActionStoreKey(3, 7))
+	require.Equal(t, int64(3), epochNumber)
+	require.Equal(t, uint64(7), actionID)
+
+	// every accessor fails loudly and names the corrupt action
+	require.PanicsWithValue(t, "failed to decode action 7 queued for epoch 3: unexpected EOF", func() {
+		k.GetEpochActionByID(ctx, 3, 7)
+	})
+	require.Panics(t, func() { k.GetEpochMsg(ctx, 3, 7) })
+	require.Panics(t, func() { k.GetEpochActions(ctx) })
+	require.Panics(t, func() {
+		k.IterateEpochActions(ctx, 3, func(sdk.Msg) bool { return false })
+	})
+}
+
+func TestEpochActionsOrdering(t *testing.T) {
+	// more actions and epochs than fit into a single byte
+	const numActions = 300
+	epochs := []int64{1, 256, 2}
+
+	queue := func() []sdk.Msg {
+		k, ctx := createTestKeeper()
+		for _, epoch := range epochs {
+			for i := int64(0); i < numActions; i++ {
+				k.QueueMsgForEpoch(ctx, epoch, newTestMsg(epoch*numActions+i+1))
+			}
+		}
+
+		var msgs []sdk.Msg
+		for _, epoch := range []int64{1, 2, 256} {
+			k.IterateEpochActions(ctx, epoch, func(msg sdk.Msg) bool {
+				msgs = append(msgs, msg)
+				return false
+			})
+		}
+		require.Equal(t, msgs, k.GetEpochActions(ctx))
+
+		return msgs
+	}
+
+	msgs := queue()
+	require.Len(t, msgs, len(epochs)*numActions)
+
+	// actions are returned sorted by epoch and then in queueing order
+	var expected []sdk.Msg
+	for _, epoch := range []int64{1, 2, 256} {
+		for i := int64(0); i < numActions; i++ {
+			expected = append(expected, newTestMsg(epoch*numActions+i+1))
+		}
+	}
+	require.Equal(t, expected, msgs)
+
+	// an independent store fed the same queue iterates in the same order
+	require.Equal(t, msgs, queue())
+}
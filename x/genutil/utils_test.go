@@ -25,8 +25,9 @@ func TestExportGenesisFileWithTime(t *testing.T) {
 func TestInitializeNodeValidatorFilesFromMnemonic(t *testing.T) {
 	t.Parallel()
 
-	cfg := config.TestConfig()
-	cfg.RootDir = t.TempDir()
+	// SetRoot also roots the priv validator files, which would otherwise be
+	// written relative to the package directory
+	cfg := config.TestConfig().SetRoot(t.TempDir())
 	require.NoError(t, os.MkdirAll(filepath.Join(cfg.RootDir, "config"), 0755))
 
 	tests := []struct {
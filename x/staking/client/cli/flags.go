@@ -26,7 +26,8 @@ const (
 	FlagCommissionMaxRate       = "commission-max-rate"
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 
-	FlagMinSelfDelegation = "min-self-delegation"
+	FlagMinSelfDelegation  = "min-self-delegation"
+	FlagMaxTotalDelegation = "max-total-delegation"
 
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
@@ -66,6 +67,13 @@ func FlagSetMinSelfDelegation() *flag.FlagSet {
 	return fs
 }
 
+// FlagSetMaxTotalDelegation Returns the FlagSet used for the maximum total delegation.
+func FlagSetMaxTotalDelegation() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagMaxTotalDelegation, "", "The maximum of tokens delegated to the validator (incl. self-delegation), 0 for no maximum")
+	return fs
+}
+
 // FlagSetAmount Returns the FlagSet for amount related operations.
 func FlagSetAmount() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
@@ -33,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryRedelegations(),
 		GetCmdQueryValidator(),
 		GetCmdQueryValidators(),
+		GetCmdQueryValidatorExchangeRate(),
+		GetCmdQueryValidatorSelfDelegationStatus(),
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
@@ -88,6 +90,96 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorExchangeRate implements the validator exchange rate query command.
+func GetCmdQueryValidatorExchangeRate() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-exchange-rate [validator-addr]",
+		Short: "Query the tokens per delegator share of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of tokens each delegator share of a validator is worth.
+
+Example:
+$ %s query staking validator-exchange-rate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorExchangeRateRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorExchangeRate(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorSelfDelegationStatus implements the validator self-delegation status query command.
+func GetCmdQueryValidatorSelfDelegationStatus() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-self-delegation-status [validator-addr]",
+		Short: "Query the self-delegation of a validator against its minimum self delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens a validator operator has self-delegated, the validator's
+minimum self delegation, and the shortfall the operator has to self-delegate to
+meet it.
+
+Example:
+$ %s query staking validator-self-delegation-status %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorSelfDelegationStatusRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorSelfDelegationStatus(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators() *cobra.Command {
 	cmd := &cobra.Command{
@@ -559,12 +559,34 @@ func (k Keeper) Delegate(
 		return sdk.ZeroDec(), types.ErrDelegatorShareExRateInvalid
 	}
 
+	// the validator operator may cap the tokens delegated to the validator
+	if validator.ExceedsMaxTotalDelegation(bondAmt) {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(
+			types.ErrMaxTotalDelegationExceeded, "validator has %s tokens, delegating %s exceeds the maximum of %s",
+			validator.Tokens, bondAmt, validator.MaxTotalDelegation,
+		)
+	}
+
 	// Get or create the delegation object
 	delegation, found := k.GetDelegation(ctx, delAddr, validator.GetOperator())
 	if !found {
 		delegation = types.NewDelegation(delAddr, validator.GetOperator(), sdk.ZeroDec())
 	}
 
+	// the delegation must meet the minimum delegation afterwards, whether the
+	// tokens come from a delegation, a redelegation or a cancelled unbonding
+	if minDelegation := k.MinDelegation(ctx); !minDelegation.IsZero() {
+		delegated := bondAmt
+		if delegation.Shares.IsPositive() {
+			delegated = delegated.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+		}
+		if delegated.LT(minDelegation) {
+			return sdk.ZeroDec(), sdkerrors.Wrapf(
+				types.ErrBelowMinDelegation, "delegation would hold %s tokens, minimum is %s", delegated, minDelegation,
+			)
+		}
+	}
+
 	// call the appropriate hook if present
 	if found {
 		err = k.BeforeDelegationSharesModified(ctx, delAddr, validator.GetOperator())
@@ -731,6 +753,36 @@ func (k Keeper) getBeginInfo(
 	}
 }
 
+// validateDelegationRemainder returns an error if removing the given shares
+// leaves the delegation with more than zero but less than the minimum
+// delegation. Unbond itself doesn't check this, as slashing unbonds
+// redelegated shares regardless of the minimum.
+func (k Keeper) validateDelegationRemainder(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, shares sdk.Dec) error {
+	minDelegation := k.MinDelegation(ctx)
+	if minDelegation.IsZero() {
+		return nil
+	}
+
+	// a missing delegation or too few shares are reported by Unbond
+	delegation, found := k.GetDelegation(ctx, delAddr, validator.GetOperator())
+	if !found {
+		return nil
+	}
+
+	remaining := delegation.Shares.Sub(shares)
+	if !remaining.IsPositive() {
+		return nil
+	}
+
+	if remainingTokens := validator.TokensFromShares(remaining).TruncateInt(); remainingTokens.LT(minDelegation) {
+		return sdkerrors.Wrapf(
+			types.ErrBelowMinDelegation, "%s tokens would remain delegated, minimum is %s", remainingTokens, minDelegation,
+		)
+	}
+
+	return nil
+}
+
 // Undelegate unbonds an amount of delegator shares from a given validator. It
 // will verify that the unbonding entries between the delegator and validator
 // are not exceeded and unbond the staked tokens (based on shares) by creating
@@ -748,6 +800,10 @@ func (k Keeper) Undelegate(
 		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
+	if err := k.validateDelegationRemainder(ctx, delAddr, validator, sharesAmount); err != nil {
+		return time.Time{}, err
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -841,6 +897,10 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
+	if err := k.validateDelegationRemainder(ctx, delAddr, srcValidator, sharesAmount); err != nil {
+		return time.Time{}, err
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -85,6 +85,76 @@ func (k Querier) Validator(c context.Context, req *types.QueryValidatorRequest)
 	return &types.QueryValidatorResponse{Validator: validator}, nil
 }
 
+// ValidatorExchangeRate queries the tokens per delegator share of a given validator
+func (k Querier) ValidatorExchangeRate(c context.Context, req *types.QueryValidatorExchangeRateRequest) (*types.QueryValidatorExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	// the first delegation to a validator without shares sets the exchange rate to one
+	exchangeRate := sdk.OneDec()
+	if !validator.DelegatorShares.IsZero() {
+		exchangeRate = validator.TokensFromShares(sdk.OneDec())
+	}
+
+	return &types.QueryValidatorExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+}
+
+// ValidatorSelfDelegationStatus queries the self-delegation of a given validator against its minimum
+func (k Querier) ValidatorSelfDelegationStatus(c context.Context, req *types.QueryValidatorSelfDelegationStatusRequest) (*types.QueryValidatorSelfDelegationStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	// the tokens are truncated the same way Unbond does before comparing them
+	// against the minimum self delegation
+	selfBonded := sdk.ZeroInt()
+	if delegation, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr); found {
+		selfBonded = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	shortfall := sdk.ZeroInt()
+	if selfBonded.LT(validator.MinSelfDelegation) {
+		shortfall = validator.MinSelfDelegation.Sub(selfBonded)
+	}
+
+	return &types.QueryValidatorSelfDelegationStatusResponse{
+		SelfBondedTokens:  selfBonded,
+		MinSelfDelegation: validator.MinSelfDelegation,
+		Shortfall:         shortfall,
+	}, nil
+}
+
 // ValidatorDelegations queries delegate info for given validator
 func (k Querier) ValidatorDelegations(c context.Context, req *types.QueryValidatorDelegationsRequest) (*types.QueryValidatorDelegationsResponse, error) {
 	if req == nil {
@@ -127,6 +127,188 @@ func (suite *KeeperTestSuite) TestGRPCQueryValidator() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorExchangeRate() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+
+	// slash half of the tokens of the second validator
+	slashed, found := app.StakingKeeper.GetValidator(ctx, vals[1].GetOperator())
+	suite.True(found)
+	slashed = app.StakingKeeper.RemoveValidatorTokens(ctx, slashed, slashed.Tokens.QuoRaw(2))
+
+	// a validator without any delegator shares
+	noShares := teststaking.NewValidator(suite.T(), sdk.ValAddress(suite.addrs[4]), simapp.CreateTestPubKeys(5)[4])
+	app.StakingKeeper.SetValidator(ctx, noShares)
+
+	var req *types.QueryValidatorExchangeRateRequest
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		expRate  sdk.Dec
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryValidatorExchangeRateRequest{}
+			},
+			false,
+			sdk.Dec{},
+		},
+		{
+			"invalid validator address",
+			func() {
+				req = &types.QueryValidatorExchangeRateRequest{ValidatorAddr: "invalid"}
+			},
+			false,
+			sdk.Dec{},
+		},
+		{
+			"validator not found",
+			func() {
+				req = &types.QueryValidatorExchangeRateRequest{ValidatorAddr: sdk.ValAddress(suite.addrs[3]).String()}
+			},
+			false,
+			sdk.Dec{},
+		},
+		{
+			"validator without slashes",
+			func() {
+				req = &types.QueryValidatorExchangeRateRequest{ValidatorAddr: vals[0].OperatorAddress}
+			},
+			true,
+			sdk.OneDec(),
+		},
+		{
+			"slashed validator",
+			func() {
+				req = &types.QueryValidatorExchangeRateRequest{ValidatorAddr: slashed.OperatorAddress}
+			},
+			true,
+			sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			"validator without delegator shares",
+			func() {
+				req = &types.QueryValidatorExchangeRateRequest{ValidatorAddr: noShares.OperatorAddress}
+			},
+			true,
+			sdk.OneDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.ValidatorExchangeRate(gocontext.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Equal(tc.expRate, res.ExchangeRate)
+			} else {
+				suite.Error(err)
+				suite.Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorSelfDelegationStatus() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+
+	met, found := app.StakingKeeper.GetValidator(ctx, vals[0].GetOperator())
+	suite.True(found)
+
+	// raise the minimum self delegation of the second validator above its self-bonded tokens
+	shortfall, found := app.StakingKeeper.GetValidator(ctx, vals[1].GetOperator())
+	suite.True(found)
+	shortfall.MinSelfDelegation = app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	app.StakingKeeper.SetValidator(ctx, shortfall)
+
+	// a validator whose operator has not self-delegated
+	noSelfDelegation := teststaking.NewValidator(suite.T(), sdk.ValAddress(suite.addrs[4]), simapp.CreateTestPubKeys(5)[4])
+	app.StakingKeeper.SetValidator(ctx, noSelfDelegation)
+
+	var req *types.QueryValidatorSelfDelegationStatusRequest
+	testCases := []struct {
+		msg           string
+		malleate      func()
+		expPass       bool
+		expSelfBonded sdk.Int
+		expMin        sdk.Int
+		expShortfall  sdk.Int
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryValidatorSelfDelegationStatusRequest{}
+			},
+			false,
+			sdk.Int{}, sdk.Int{}, sdk.Int{},
+		},
+		{
+			"invalid validator address",
+			func() {
+				req = &types.QueryValidatorSelfDelegationStatusRequest{ValidatorAddr: "invalid"}
+			},
+			false,
+			sdk.Int{}, sdk.Int{}, sdk.Int{},
+		},
+		{
+			"validator not found",
+			func() {
+				req = &types.QueryValidatorSelfDelegationStatusRequest{ValidatorAddr: sdk.ValAddress(suite.addrs[3]).String()}
+			},
+			false,
+			sdk.Int{}, sdk.Int{}, sdk.Int{},
+		},
+		{
+			"minimum self delegation met",
+			func() {
+				req = &types.QueryValidatorSelfDelegationStatusRequest{ValidatorAddr: met.OperatorAddress}
+			},
+			true,
+			app.StakingKeeper.TokensFromConsensusPower(ctx, 9),
+			met.MinSelfDelegation,
+			sdk.ZeroInt(),
+		},
+		{
+			"minimum self delegation not met",
+			func() {
+				req = &types.QueryValidatorSelfDelegationStatusRequest{ValidatorAddr: shortfall.OperatorAddress}
+			},
+			true,
+			app.StakingKeeper.TokensFromConsensusPower(ctx, 8),
+			app.StakingKeeper.TokensFromConsensusPower(ctx, 10),
+			app.StakingKeeper.TokensFromConsensusPower(ctx, 2),
+		},
+		{
+			"validator without self delegation",
+			func() {
+				req = &types.QueryValidatorSelfDelegationStatusRequest{ValidatorAddr: noSelfDelegation.OperatorAddress}
+			},
+			true,
+			sdk.ZeroInt(),
+			noSelfDelegation.MinSelfDelegation,
+			noSelfDelegation.MinSelfDelegation,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.malleate()
+			res, err := queryClient.ValidatorSelfDelegationStatus(gocontext.Background(), req)
+			if tc.expPass {
+				suite.NoError(err)
+				suite.Equal(tc.expSelfBonded, res.SelfBondedTokens)
+				suite.Equal(tc.expMin, res.MinSelfDelegation)
+				suite.Equal(tc.expShortfall, res.Shortfall)
+			} else {
+				suite.Error(err)
+				suite.Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryDelegatorValidators() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 	params := app.StakingKeeper.GetParams(ctx)
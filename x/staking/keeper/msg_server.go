@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -35,18 +36,54 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
+	// reject malformed or inconsistent commission rates before any state is
+	// written or coins are moved
+	if err := msg.Commission.Validate(); err != nil {
+		return nil, err
+	}
+
 	if msg.Commission.Rate.LT(k.MinCommissionRate(ctx)) {
 		return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
 	}
 
+	// a zero minimum self delegation, which ValidateBasic can't check against
+	// the params, is only valid when the chain opted in to it
+	if !k.AllowZeroSelfDelegation(ctx) && !msg.MinSelfDelegation.IsPositive() {
+		return nil, sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"minimum self delegation must be a positive integer",
+		)
+	}
+
+	// a zero self-delegation is only valid with a zero minimum self delegation
+	if msg.Value.Amount.LT(msg.MinSelfDelegation) {
+		return nil, types.ErrSelfDelegationBelowMinimum
+	}
+
 	// check to see if the pubkey or sender has been registered before
 	if _, found := k.GetValidator(ctx, valAddr); found {
 		return nil, types.ErrValidatorOwnerExists
 	}
 
-	pk, ok := msg.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+	if msg.Pubkey == nil {
+		return nil, types.ErrEmptyValidatorPubKey
+	}
+
+	// the pubkey is only cached if the msg was unpacked when decoded
+	if msg.Pubkey.GetCachedValue() == nil {
+		if err := msg.UnpackInterfaces(k.cdc); err != nil {
+			return nil, err
+		}
+	}
+
+	cachedPk := msg.Pubkey.GetCachedValue()
+	if cachedPk == nil {
+		return nil, types.ErrEmptyValidatorPubKey
+	}
+
+	pk, ok := cachedPk.(cryptotypes.PubKey)
 	if !ok {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", cachedPk)
 	}
 
 	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
@@ -64,14 +101,18 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
-	cp := ctx.ConsensusParams()
-	if cp != nil && cp.Validator != nil {
-		if !tmstrings.StringInSlice(pk.Type(), cp.Validator.PubKeyTypes) {
-			return nil, sdkerrors.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
-			)
-		}
+	// fall back to the module's allow-list when the consensus params do not
+	// restrict the validator pubkey types
+	pubKeyTypes := k.PubKeyTypes(ctx)
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Validator != nil {
+		pubKeyTypes = cp.Validator.PubKeyTypes
+	}
+
+	if !tmstrings.StringInSlice(pk.Type(), pubKeyTypes) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrValidatorPubKeyTypeNotSupported,
+			"got: %s, expected: %s", pk.Type(), pubKeyTypes,
+		)
 	}
 
 	validator, err := types.NewValidator(valAddr, pk, msg.Description)
@@ -96,23 +137,41 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 
 	validator.MinSelfDelegation = msg.MinSelfDelegation
 
-	k.SetValidator(ctx, validator)
-	k.SetValidatorByConsAddr(ctx, validator)
-	k.SetNewValidatorByPowerIndex(ctx, validator)
+	// When delivered in a tx, baseapp already discards the state of a failed
+	// msg. The cached context only protects callers invoking the msg server
+	// directly, such as tests and other modules, from an orphaned validator
+	// when the self-delegation cannot be funded. Its events and state are only
+	// committed together below, once every step has succeeded.
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	k.SetValidator(cacheCtx, validator)
+	k.SetValidatorByConsAddr(cacheCtx, validator)
+	k.SetNewValidatorByPowerIndex(cacheCtx, validator)
 
 	// call the after-creation hook
-	if err := k.AfterValidatorCreated(ctx, validator.GetOperator()); err != nil {
+	if err := k.AfterValidatorCreated(cacheCtx, validator.GetOperator()); err != nil {
 		return nil, err
 	}
 
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
 	// NOTE source will always be from a wallet which are unbonded
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Value.Amount, types.Unbonded, validator, true)
-	if err != nil {
-		return nil, err
+	//
+	// A zero self-delegation is only allowed when the minimum self delegation
+	// is zero as well (see MsgCreateValidator.ValidateBasic), in which case the
+	// validator is created without any tokens or delegation record.
+	if !msg.Value.Amount.IsZero() {
+		_, err = k.Keeper.Delegate(cacheCtx, delegatorAddress, msg.Value.Amount, types.Unbonded, validator, true)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	// CacheContext gives the cached context its own EventManager, so its
+	// events must be re-emitted whenever its state is written.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateValidator,
@@ -176,6 +235,12 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		validator.MinSelfDelegation = (*msg.MinSelfDelegation)
 	}
 
+	// the maximum only limits new delegations, so it may be set below the
+	// tokens the validator already holds
+	if msg.MaxTotalDelegation != nil {
+		validator.MaxTotalDelegation = (*msg.MaxTotalDelegation)
+	}
+
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -183,6 +248,7 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 			types.EventTypeEditValidator,
 			sdk.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.String()),
 			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxTotalDelegation, validator.MaxTotalDelegation.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -260,10 +326,20 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 	if err != nil {
 		return nil, err
 	}
+	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, err
+	}
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
+
+	// reject a no-op redelegation before looking up any delegation
+	if valSrcAddr.Equals(valDstAddr) {
+		return nil, types.ErrSelfRedelegation
+	}
+
 	shares, err := k.ValidateUnbondAmount(
 		ctx, delegatorAddress, valSrcAddr, msg.Amount.Amount,
 	)
@@ -278,11 +354,6 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		)
 	}
 
-	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
-	if err != nil {
-		return nil, err
-	}
-
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -321,6 +392,94 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 	}, nil
 }
 
+// BeginRedelegatePercent defines a method for performing a redelegation of a percentage of a delegation
+// from a source validator to a destination validator
+func (k msgServer) BeginRedelegatePercent(goCtx context.Context, msg *types.MsgBeginRedelegatePercent) (*types.MsgBeginRedelegatePercentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valSrcAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
+	if err != nil {
+		return nil, err
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if valSrcAddr.Equals(valDstAddr) {
+		return nil, types.ErrSelfRedelegation
+	}
+
+	validator, found := k.GetValidator(ctx, valSrcAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valSrcAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	// redelegate the exact delegation shares for 100% so that no dust is left
+	// behind, and round down otherwise so the shares never exceed the delegation
+	shares := delegation.Shares
+	if msg.Percent.LT(sdk.OneDec()) {
+		shares = delegation.Shares.MulTruncate(msg.Percent)
+	}
+	if !shares.IsPositive() {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "%s of the delegation shares %s rounds to zero", msg.Percent, delegation.Shares,
+		)
+	}
+
+	// the tokens are worked out the same way Unbond removes them from the
+	// validator, so the returned amount matches the redelegation entry
+	_, amount := validator.RemoveDelShares(shares)
+
+	completionTime, err := k.BeginRedelegation(
+		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	redelegateAmount := sdk.NewCoin(k.BondDenom(ctx), amount)
+
+	if amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "redelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", redelegateAmount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegate,
+			sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddress),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, redelegateAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgBeginRedelegatePercentResponse{
+		CompletionTime: completionTime,
+		Amount:         redelegateAmount,
+	}, nil
+}
+
 // Undelegate defines a method for performing an undelegation from a delegate and a validator
 func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -381,3 +540,178 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		CompletionTime: completionTime,
 	}, nil
 }
+
+// UndelegateShares defines a method for performing an undelegation of an exact
+// amount of shares from a delegate and a validator
+func (k msgServer) UndelegateShares(goCtx context.Context, msg *types.MsgUndelegateShares) (*types.MsgUndelegateSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	if msg.Shares.GT(delegation.Shares) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrNotEnoughDelegationShares, "got %s shares, delegation has %s", msg.Shares, delegation.Shares,
+		)
+	}
+
+	// the tokens are worked out the same way Unbond removes them from the
+	// validator, so the returned amount matches the unbonding entry
+	_, amount := validator.RemoveDelShares(msg.Shares)
+
+	completionTime, err := k.Keeper.Undelegate(ctx, delegatorAddress, valAddr, msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondAmount := sdk.NewCoin(k.BondDenom(ctx), amount)
+
+	if amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "undelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", unbondAmount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, unbondAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgUndelegateSharesResponse{
+		CompletionTime: completionTime,
+		Amount:         unbondAmount,
+	}, nil
+}
+
+// CancelUnbondingDelegation defines a method for cancelling an unbonding
+// delegation entry and delegating its balance back to the validator
+func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if validator.IsJailed() {
+		return nil, types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoUnbondingDelegation
+	}
+
+	var (
+		unbondEntry      types.UnbondingDelegationEntry
+		unbondEntryIndex int64 = -1
+	)
+
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == msg.CreationHeight {
+			unbondEntry = entry
+			unbondEntryIndex = int64(i)
+			break
+		}
+	}
+
+	if unbondEntryIndex == -1 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", msg.CreationHeight)
+	}
+
+	if unbondEntry.Balance.LT(msg.Amount.Amount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
+	}
+
+	if unbondEntry.IsMature(ctx.BlockHeader().Time) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+	}
+
+	// delegate back the unbonding delegation amount to the validator; the
+	// tokens are still held by the not bonded pool
+	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := unbondEntry.Balance.Sub(msg.Amount.Amount)
+	if amount.IsZero() {
+		ubd.RemoveEntry(unbondEntryIndex)
+	} else {
+		// update the balance and initial balance of the remaining entry
+		unbondEntry.Balance = amount
+		unbondEntry.InitialBalance = unbondEntry.InitialBalance.Sub(msg.Amount.Amount)
+		ubd.Entries[unbondEntryIndex] = unbondEntry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgCancelUnbondingDelegationResponse{}, nil
+}
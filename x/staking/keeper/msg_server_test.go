@@ -0,0 +1,897 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestCreateValidatorInvalidCommissionRates(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	addrDels, addrVals := generateAddresses(app, ctx, 1)
+	balanceBefore := app.BankKeeper.GetBalance(ctx, addrDels[0], sdk.DefaultBondDenom)
+
+	testCases := []struct {
+		name       string
+		commission types.CommissionRates
+		expErr     error
+	}{
+		{
+			"rate greater than max rate",
+			types.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(1, 1)),
+			types.ErrCommissionGTMaxRate,
+		},
+		{
+			"max change rate greater than max rate",
+			types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1)),
+			types.ErrCommissionChangeRateGTMaxRate,
+		},
+		{
+			"negative rate",
+			types.NewCommissionRates(sdk.NewDecWithPrec(-1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1)),
+			types.ErrCommissionNegative,
+		},
+		{
+			"negative max rate",
+			types.NewCommissionRates(sdk.ZeroDec(), sdk.NewDecWithPrec(-2, 1), sdk.ZeroDec()),
+			types.ErrCommissionNegative,
+		},
+		{
+			"negative max change rate",
+			types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(-1, 1)),
+			types.ErrCommissionChangeRateNegative,
+		},
+		{
+			"max rate greater than one",
+			types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(11, 1), sdk.NewDecWithPrec(1, 1)),
+			types.ErrCommissionHuge,
+		},
+		{
+			"rate greater than one",
+			types.NewCommissionRates(sdk.NewDec(2), sdk.NewDec(2), sdk.NewDecWithPrec(1, 1)),
+			types.ErrCommissionHuge,
+		},
+		{
+			"unset rate",
+			types.NewCommissionRates(sdk.Dec{}, sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1)),
+			sdkerrors.ErrInvalidRequest,
+		},
+		{
+			"smallest representable rate",
+			types.NewCommissionRates(sdk.SmallestDec(), sdk.OneDec(), sdk.OneDec().Sub(sdk.SmallestDec())),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := types.NewMsgCreateValidator(
+				addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				types.NewDescription("moniker", "", "", "", ""), tc.commission, sdk.OneInt(),
+			)
+			require.NoError(t, err)
+
+			// run on a cached context so that the valid case doesn't leak into the others
+			cacheCtx, _ := ctx.CacheContext()
+			_, err = msgServer.CreateValidator(sdk.WrapSDKContext(cacheCtx), msg)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expErr)
+
+			_, found := app.StakingKeeper.GetValidator(cacheCtx, addrVals[0])
+			require.False(t, found)
+			require.Equal(t, balanceBefore, app.BankKeeper.GetBalance(cacheCtx, addrDels[0], sdk.DefaultBondDenom))
+		})
+	}
+
+	t.Run("rate with excessive precision", func(t *testing.T) {
+		msg, err := types.NewMsgCreateValidator(
+			addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			types.NewDescription("moniker", "", "", "", ""),
+			types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1)), sdk.OneInt(),
+		)
+		require.NoError(t, err)
+
+		bz, err := app.AppCodec().MarshalJSON(msg)
+		require.NoError(t, err)
+		require.Contains(t, string(bz), `"rate":"0.100000000000000000"`)
+
+		// rates never carry more than sdk.Precision decimals into the msg server
+		bz = bytes.Replace(bz, []byte(`"rate":"0.100000000000000000"`), []byte(`"rate":"0.1000000000000000001"`), 1)
+		require.Error(t, app.AppCodec().UnmarshalJSON(bz, &types.MsgCreateValidator{}))
+	})
+}
+
+func TestCreateValidatorInsufficientFunds(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	addrDels, addrVals := generateAddresses(app, ctx, 1)
+	balance := app.BankKeeper.GetBalance(ctx, addrDels[0], sdk.DefaultBondDenom)
+
+	msg, err := types.NewMsgCreateValidator(
+		addrVals[0], PKs[0], balance.AddAmount(sdk.OneInt()),
+		types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+
+	// no orphaned validator records are left behind
+	_, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.False(t, found)
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addrDels[0], sdk.DefaultBondDenom))
+}
+
+// createValidatorWithUnbonding creates a validator self-delegating 100 tokens
+// and unbonds 10 of them, returning the addresses and the unbonding completion
+// time.
+func createValidatorWithUnbonding(t *testing.T, app *simapp.SimApp, ctx sdk.Context) (sdk.AccAddress, sdk.ValAddress, time.Time) {
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	addrDels, addrVals := generateAddresses(app, ctx, 1)
+
+	msg, err := types.NewMsgCreateValidator(
+		addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(10))
+	require.NoError(t, err)
+
+	return addrDels[0], addrVals[0], completionTime
+}
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delAddr, valAddr, _ := createValidatorWithUnbonding(t, app, ctx)
+	unbondAmount := sdk.NewInt64Coin(bondDenom, 10)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	creationHeight := ubd.Entries[0].CreationHeight
+	testCases := []struct {
+		name     string
+		msg      *types.MsgCancelUnbondingDelegation
+		expErr   bool
+		expEntry sdk.Int
+	}{
+		{
+			"invalid bond denom",
+			types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin("dump", 4)),
+			true, unbondAmount.Amount,
+		},
+		{
+			"entry not found at creation height",
+			types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight+1, sdk.NewInt64Coin(bondDenom, 4)),
+			true, unbondAmount.Amount,
+		},
+		{
+			"amount greater than the entry balance",
+			types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, unbondAmount.AddAmount(sdk.OneInt())),
+			true, unbondAmount.Amount,
+		},
+		{
+			"partial cancel",
+			types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin(bondDenom, 4)),
+			false, sdk.NewInt(6),
+		},
+		{
+			"cancel the remaining balance",
+			types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin(bondDenom, 6)),
+			false, sdk.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+			if tc.expEntry.IsZero() {
+				require.False(t, found)
+				return
+			}
+			require.True(t, found)
+			require.Equal(t, tc.expEntry, ubd.Entries[0].Balance)
+		})
+	}
+
+	// the cancelled tokens are delegated back to the validator
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegation.Shares)
+}
+
+func TestCancelUnbondingDelegationMatureEntry(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delAddr, valAddr, completionTime := createValidatorWithUnbonding(t, app, ctx)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(completionTime)
+	msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, ubd.Entries[0].CreationHeight, sdk.NewInt64Coin(bondDenom, 10))
+	_, err := msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestCreateValidatorZeroSelfDelegation(t *testing.T) {
+	testCases := []struct {
+		name              string
+		allowZero         bool
+		minSelfDelegation sdk.Int
+		expErr            error
+	}{
+		{"zero min self delegation", true, sdk.ZeroInt(), nil},
+		{"positive min self delegation", true, sdk.OneInt(), types.ErrSelfDelegationBelowMinimum},
+		{"zero min self delegation not allowed", false, sdk.ZeroInt(), sdkerrors.ErrInvalidRequest},
+		{"positive min self delegation, zero not allowed", false, sdk.OneInt(), types.ErrSelfDelegationBelowMinimum},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, app, ctx := createTestInput(t)
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+			params := app.StakingKeeper.GetParams(ctx)
+			params.AllowZeroSelfDelegation = tc.allowZero
+			app.StakingKeeper.SetParams(ctx, params)
+
+			addrDels, addrVals := generateAddresses(app, ctx, 1)
+			balance := app.BankKeeper.GetBalance(ctx, addrDels[0], sdk.DefaultBondDenom)
+
+			msg, err := types.NewMsgCreateValidator(
+				addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), tc.minSelfDelegation,
+			)
+			require.NoError(t, err)
+
+			_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+			require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addrDels[0], sdk.DefaultBondDenom))
+			_, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+			require.False(t, found)
+
+			validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.False(t, found)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, types.Unbonded, validator.Status)
+			require.True(t, validator.Tokens.IsZero())
+			require.True(t, validator.DelegatorShares.IsZero())
+
+			// the validator accepts delegations afterwards
+			_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrDels[0], addrVals[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+			require.NoError(t, err)
+			delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+			require.True(t, found)
+			require.Equal(t, sdk.NewDec(10), delegation.Shares)
+		})
+	}
+
+	t.Run("positive self-delegation, zero min self delegation not allowed", func(t *testing.T) {
+		_, app, ctx := createTestInput(t)
+		msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+		require.False(t, app.StakingKeeper.AllowZeroSelfDelegation(ctx))
+
+		_, addrVals := generateAddresses(app, ctx, 1)
+		msg, err := types.NewMsgCreateValidator(
+			addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+			types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.ZeroInt(),
+		)
+		require.NoError(t, err)
+		_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		_, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+		require.False(t, found)
+	})
+}
+
+func TestCreateValidatorPubKeyUnpacking(t *testing.T) {
+	pkAny, err := codectypes.NewAnyWithValue(PKs[0])
+	require.NoError(t, err)
+	notPkAny, err := codectypes.NewAnyWithValue(&types.Description{Moniker: "moniker"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		pubkey *codectypes.Any
+		expErr error
+	}{
+		{"cached pubkey", pkAny, nil},
+		{"uncached pubkey", &codectypes.Any{TypeUrl: pkAny.TypeUrl, Value: pkAny.Value}, nil},
+		{"nil pubkey", nil, types.ErrEmptyValidatorPubKey},
+		{"empty pubkey", &codectypes.Any{}, types.ErrEmptyValidatorPubKey},
+		{"cached value is not a pubkey", notPkAny, sdkerrors.ErrInvalidType},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, app, ctx := createTestInput(t)
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+			_, addrVals := generateAddresses(app, ctx, 1)
+			msg, err := types.NewMsgCreateValidator(
+				addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+			)
+			require.NoError(t, err)
+			msg.Pubkey = tc.pubkey
+
+			_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+			require.True(t, found)
+			consAddr, err := validator.GetConsAddr()
+			require.NoError(t, err)
+			require.Equal(t, sdk.ConsAddress(PKs[0].Address()), consAddr)
+		})
+	}
+}
+
+func TestCreateValidatorPubKeyTypes(t *testing.T) {
+	secpPk := secp256k1.GenPrivKey().PubKey()
+
+	testCases := []struct {
+		name            string
+		pk              cryptotypes.PubKey
+		consensusParams *tmproto.ConsensusParams
+		pubKeyTypes     []string
+		expErr          bool
+	}{
+		{"nil consensus params, default allow-list", PKs[0], nil, types.DefaultPubKeyTypes, false},
+		{"nil consensus params, key type not allowed", secpPk, nil, types.DefaultPubKeyTypes, true},
+		{"nil consensus params, key type allowed by param", secpPk, nil, []string{tmtypes.ABCIPubKeyTypeSecp256k1}, false},
+		{"nil validator params, key type not allowed", secpPk, &tmproto.ConsensusParams{}, types.DefaultPubKeyTypes, true},
+		{
+			"consensus params take precedence over the param",
+			secpPk,
+			&tmproto.ConsensusParams{Validator: &tmproto.ValidatorParams{PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeSecp256k1}}},
+			types.DefaultPubKeyTypes,
+			false,
+		},
+		{
+			"key type not allowed by consensus params",
+			PKs[0],
+			&tmproto.ConsensusParams{Validator: &tmproto.ValidatorParams{PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeSecp256k1}}},
+			types.DefaultPubKeyTypes,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, app, ctx := createTestInput(t)
+			ctx = ctx.WithConsensusParams(tc.consensusParams)
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+			params := app.StakingKeeper.GetParams(ctx)
+			params.PubKeyTypes = tc.pubKeyTypes
+			app.StakingKeeper.SetParams(ctx, params)
+
+			_, addrVals := generateAddresses(app, ctx, 1)
+			msg, err := types.NewMsgCreateValidator(
+				addrVals[0], tc.pk, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+			)
+			require.NoError(t, err)
+
+			_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrValidatorPubKeyTypeNotSupported)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBeginRedelegateSelfRedelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	require.Len(t, delegations, 1)
+	delAddr := delegations[0].GetDelegatorAddr()
+	valAddr := delegations[0].GetValidatorAddr()
+
+	msg := types.NewMsgBeginRedelegate(delAddr, valAddr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	_, err := msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrSelfRedelegation)
+
+	// the source delegation is left untouched
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, delegations[0].Shares, delegation.Shares)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, delAddr, valAddr, valAddr)
+	require.False(t, found)
+}
+
+func TestMinDelegation(t *testing.T) {
+	minDelegation := sdk.NewInt(50)
+
+	testCases := []struct {
+		name   string
+		amount sdk.Int
+		expErr bool
+	}{
+		{"below the minimum", minDelegation.Sub(sdk.OneInt()), true},
+		{"at the minimum", minDelegation, false},
+		{"above the minimum", minDelegation.Add(sdk.OneInt()), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, app, ctx := createTestInput(t)
+			msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+			params := app.StakingKeeper.GetParams(ctx)
+			params.MinDelegation = minDelegation
+			app.StakingKeeper.SetParams(ctx, params)
+
+			addrDels, addrVals := generateAddresses(app, ctx, 2)
+			amount := sdk.NewCoin(sdk.DefaultBondDenom, tc.amount)
+
+			createMsg, err := types.NewMsgCreateValidator(
+				addrVals[0], PKs[0], amount,
+				types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+			)
+			require.NoError(t, err)
+			_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), createMsg)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// delegate to the genesis validator
+			valAddr := app.StakingKeeper.GetAllDelegations(ctx)[0].GetValidatorAddr()
+			_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrDels[1], valAddr, amount))
+			_, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddr)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+				require.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, found)
+		})
+	}
+
+	t.Run("zero self-delegation", func(t *testing.T) {
+		_, app, ctx := createTestInput(t)
+		msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+		params := app.StakingKeeper.GetParams(ctx)
+		params.MinDelegation = minDelegation
+		params.AllowZeroSelfDelegation = true
+		app.StakingKeeper.SetParams(ctx, params)
+
+		_, addrVals := generateAddresses(app, ctx, 1)
+		msg, err := types.NewMsgCreateValidator(
+			addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+			types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.ZeroInt(),
+		)
+		require.NoError(t, err)
+		_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	})
+}
+
+// setupMinDelegation sets a minimum delegation of 50 tokens and creates an
+// unbonded validator self-delegating 100 tokens with a 60 token delegation
+// from a second account.
+func setupMinDelegation(t *testing.T, app *simapp.SimApp, ctx sdk.Context) (sdk.AccAddress, sdk.ValAddress) {
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinDelegation = sdk.NewInt(50)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	addrDels, addrVals := generateAddresses(app, ctx, 2)
+	msg, err := types.NewMsgCreateValidator(
+		addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrDels[1], addrVals[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)))
+	require.NoError(t, err)
+
+	return addrDels[1], addrVals[0]
+}
+
+func TestMinDelegationUndelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delAddr, valAddr := setupMinDelegation(t, app, ctx)
+
+	// a partial unbonding must leave at least the minimum behind; failed msgs
+	// are reverted by the tx, so run them on a branch
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgServer.Undelegate(sdk.WrapSDKContext(cacheCtx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 20)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.UndelegateShares(sdk.WrapSDKContext(cacheCtx), types.NewMsgUndelegateShares(delAddr, valAddr, sdk.NewDec(20)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 10)))
+	require.NoError(t, err)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(50), delegation.Shares)
+
+	// unbonding everything is always allowed
+	_, err = msgServer.UndelegateShares(sdk.WrapSDKContext(ctx), types.NewMsgUndelegateShares(delAddr, valAddr, sdk.NewDec(50)))
+	require.NoError(t, err)
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+}
+
+func TestMinDelegationRedelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	dstAddr := app.StakingKeeper.GetAllDelegations(ctx)[0].GetValidatorAddr()
+	delAddr, srcAddr := setupMinDelegation(t, app, ctx)
+
+	// a new delegation record on the destination validator has to meet the
+	// minimum; failed msgs are reverted by the tx, so run them on a branch
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgServer.BeginRedelegate(sdk.WrapSDKContext(cacheCtx), types.NewMsgBeginRedelegate(delAddr, srcAddr, dstAddr, sdk.NewInt64Coin(bondDenom, 10)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, dstAddr, sdk.NewInt64Coin(bondDenom, 50)))
+	require.NoError(t, err)
+
+	// the minimum doesn't apply to tokens added to an existing delegation record
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegate(delAddr, srcAddr, dstAddr, sdk.NewInt64Coin(bondDenom, 10)))
+	require.NoError(t, err)
+
+	// the source delegation must keep at least the minimum
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(cacheCtx), types.NewMsgBeginRedelegate(delAddr, srcAddr, dstAddr, sdk.NewInt64Coin(bondDenom, 20)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.BeginRedelegatePercent(sdk.WrapSDKContext(cacheCtx), types.NewMsgBeginRedelegatePercent(delAddr, srcAddr, dstAddr, sdk.NewDecWithPrec(5, 1)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	_, err = msgServer.BeginRedelegatePercent(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegatePercent(delAddr, srcAddr, dstAddr, sdk.OneDec()))
+	require.NoError(t, err)
+	_, found := app.StakingKeeper.GetDelegation(ctx, delAddr, srcAddr)
+	require.False(t, found)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, dstAddr)
+	require.True(t, found)
+	dstValidator, found := app.StakingKeeper.GetValidator(ctx, dstAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(110), dstValidator.TokensFromShares(delegation.Shares).RoundInt())
+}
+
+func TestMinDelegationCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delAddr, valAddr := setupMinDelegation(t, app, ctx)
+	_, err := msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 60)))
+	require.NoError(t, err)
+	_, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	creationHeight := ubd.Entries[0].CreationHeight
+
+	// cancelling recreates the removed delegation record, which has to meet
+	// the minimum
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(cacheCtx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin(bondDenom, 10)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin(bondDenom, 50)))
+	require.NoError(t, err)
+
+	// the minimum doesn't apply to an existing delegation record
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, sdk.NewInt64Coin(bondDenom, 10)))
+	require.NoError(t, err)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(60), delegation.Shares)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+}
+
+func TestMaxTotalDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	srcAddr := app.StakingKeeper.GetAllDelegations(ctx)[0].GetValidatorAddr()
+	addrDels, addrVals := generateAddresses(app, ctx, 3)
+	msg, err := types.NewMsgCreateValidator(
+		addrVals[0], PKs[0], sdk.NewInt64Coin(bondDenom, 100),
+		types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.MaxTotalDelegation.IsZero())
+
+	maxTotalDelegation := sdk.NewInt(150)
+	edit := types.NewMsgEditValidator(addrVals[0], types.Description{Moniker: "moniker"}, nil, nil, &maxTotalDelegation)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), edit)
+	require.NoError(t, err)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, maxTotalDelegation, validator.MaxTotalDelegation)
+
+	// delegations are accepted in order until the maximum is reached; failed
+	// msgs are reverted by the tx, so run them on a branch
+	testCases := []struct {
+		name   string
+		amount int64
+		expErr bool
+	}{
+		{"below the maximum", 30, false},
+		{"above the maximum", 21, true},
+		{"up to the maximum", 20, false},
+		{"above the reached maximum", 1, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, writeCache := ctx.CacheContext()
+			_, err := msgServer.Delegate(sdk.WrapSDKContext(cacheCtx), types.NewMsgDelegate(addrDels[1], addrVals[0], sdk.NewInt64Coin(bondDenom, tc.amount)))
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrMaxTotalDelegationExceeded)
+				return
+			}
+			require.NoError(t, err)
+			writeCache()
+		})
+	}
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, maxTotalDelegation, validator.Tokens)
+
+	// redelegations can't take the validator above the maximum either
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrDels[2], srcAddr, sdk.NewInt64Coin(bondDenom, 10)))
+	require.NoError(t, err)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(cacheCtx), types.NewMsgBeginRedelegate(addrDels[2], srcAddr, addrVals[0], sdk.NewInt64Coin(bondDenom, 10)))
+	require.ErrorIs(t, err, types.ErrMaxTotalDelegationExceeded)
+
+	// the maximum may be lowered below the delegated tokens, which only keeps
+	// new delegations out
+	maxTotalDelegation = sdk.NewInt(120)
+	edit = types.NewMsgEditValidator(addrVals[0], types.Description{Moniker: "moniker"}, nil, nil, &maxTotalDelegation)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), edit)
+	require.NoError(t, err)
+
+	// a zero maximum removes it
+	zero := sdk.ZeroInt()
+	edit = types.NewMsgEditValidator(addrVals[0], types.Description{Moniker: "moniker"}, nil, nil, &zero)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), edit)
+	require.NoError(t, err)
+
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegate(addrDels[2], srcAddr, addrVals[0], sdk.NewInt64Coin(bondDenom, 10)))
+	require.NoError(t, err)
+}
+
+// createValidatorWithFractionalExRate creates a validator self-delegating 100
+// tokens and a 30 token delegation from a second account, and then removes 30
+// tokens from the validator so that each share is worth 10/13 of a token.
+func createValidatorWithFractionalExRate(t *testing.T, app *simapp.SimApp, ctx sdk.Context) (sdk.AccAddress, sdk.ValAddress) {
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	addrDels, addrVals := generateAddresses(app, ctx, 2)
+
+	msg, err := types.NewMsgCreateValidator(
+		addrVals[0], PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		types.NewDescription("moniker", "", "", "", ""), types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addrDels[1], addrVals[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)))
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	app.StakingKeeper.RemoveValidatorTokens(ctx, validator, sdk.NewInt(30))
+
+	return addrDels[1], addrVals[0]
+}
+
+func TestUndelegateShares(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	delAddr, valAddr := createValidatorWithFractionalExRate(t, app, ctx)
+	otherAddrs, _ := generateAddresses(app, ctx, 3)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUndelegateShares
+		expErr error
+	}{
+		{"validator not found", types.NewMsgUndelegateShares(delAddr, sdk.ValAddress(otherAddrs[2]), sdk.NewDec(1)), types.ErrNoValidatorFound},
+		{"delegation not found", types.NewMsgUndelegateShares(otherAddrs[2], valAddr, sdk.NewDec(1)), types.ErrNoDelegation},
+		{"more than the delegation shares", types.NewMsgUndelegateShares(delAddr, valAddr, sdk.NewDec(30).Add(sdk.SmallestDec())), types.ErrNotEnoughDelegationShares},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UndelegateShares(sdk.WrapSDKContext(ctx), tc.msg)
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+
+	res, err := msgServer.UndelegateShares(sdk.WrapSDKContext(ctx), types.NewMsgUndelegateShares(delAddr, valAddr, sdk.NewDec(13)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), res.Amount)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(17), delegation.Shares)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, res.Amount.Amount, ubd.Entries[0].Balance)
+	require.Equal(t, res.CompletionTime, ubd.Entries[0].CompletionTime)
+}
+
+func TestUndelegateSharesVsTokensRounding(t *testing.T) {
+	// the 30 shares of the delegator are worth 23.07... tokens
+
+	t.Run("token based undelegation leaves dust shares", func(t *testing.T) {
+		_, app, ctx := createTestInput(t)
+		msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+		delAddr, valAddr := createValidatorWithFractionalExRate(t, app, ctx)
+
+		// 24 tokens are worth more shares than the delegation holds
+		_, err := msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 24)))
+		require.Error(t, err)
+
+		_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(delAddr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 23)))
+		require.NoError(t, err)
+
+		delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.True(t, found)
+		require.Equal(t, sdk.NewDecWithPrec(1, 1), delegation.Shares)
+	})
+
+	t.Run("share based undelegation fully exits", func(t *testing.T) {
+		_, app, ctx := createTestInput(t)
+		msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+		delAddr, valAddr := createValidatorWithFractionalExRate(t, app, ctx)
+
+		res, err := msgServer.UndelegateShares(sdk.WrapSDKContext(ctx), types.NewMsgUndelegateShares(delAddr, valAddr, sdk.NewDec(30)))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 23), res.Amount)
+
+		_, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.False(t, found)
+
+		ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+		require.True(t, found)
+		require.Equal(t, sdk.NewInt(23), ubd.Entries[0].Balance)
+	})
+}
+
+func TestBeginRedelegatePercent(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	dstAddr := app.StakingKeeper.GetAllDelegations(ctx)[0].GetValidatorAddr()
+	delAddr, srcAddr := createValidatorWithFractionalExRate(t, app, ctx)
+	otherAddrs, _ := generateAddresses(app, ctx, 3)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgBeginRedelegatePercent
+		expErr error
+	}{
+		{"self redelegation", types.NewMsgBeginRedelegatePercent(delAddr, srcAddr, srcAddr, sdk.OneDec()), types.ErrSelfRedelegation},
+		{"source validator not found", types.NewMsgBeginRedelegatePercent(delAddr, sdk.ValAddress(otherAddrs[2]), dstAddr, sdk.OneDec()), types.ErrNoValidatorFound},
+		{"destination validator not found", types.NewMsgBeginRedelegatePercent(delAddr, srcAddr, sdk.ValAddress(otherAddrs[2]), sdk.OneDec()), types.ErrBadRedelegationDst},
+		{"delegation not found", types.NewMsgBeginRedelegatePercent(otherAddrs[2], srcAddr, dstAddr, sdk.OneDec()), types.ErrNoDelegation},
+		{"percent worth no tokens", types.NewMsgBeginRedelegatePercent(delAddr, srcAddr, dstAddr, sdk.SmallestDec()), types.ErrTinyRedelegationAmount},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// failed msgs are reverted by the tx, so run them on a branch
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := msgServer.BeginRedelegatePercent(sdk.WrapSDKContext(cacheCtx), tc.msg)
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+
+	// half of the 30 shares are worth 11.53... tokens
+	res, err := msgServer.BeginRedelegatePercent(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegatePercent(delAddr, srcAddr, dstAddr, sdk.NewDecWithPrec(5, 1)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 11), res.Amount)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, srcAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(15), delegation.Shares)
+
+	// the source validator is unbonded, so the tokens are delegated to the
+	// destination validator right away
+	dstDelegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, dstAddr)
+	require.True(t, found)
+	dstValidator, found := app.StakingKeeper.GetValidator(ctx, dstAddr)
+	require.True(t, found)
+	require.Equal(t, res.Amount.Amount, dstValidator.TokensFromShares(dstDelegation.Shares).TruncateInt())
+
+	// redelegating 100% moves the remaining shares without leaving dust
+	res, err = msgServer.BeginRedelegatePercent(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegatePercent(delAddr, srcAddr, dstAddr, sdk.OneDec()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 11), res.Amount)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddr, srcAddr)
+	require.False(t, found)
+
+	dstDelegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, dstAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(22), dstValidator.TokensFromShares(dstDelegation.Shares).TruncateInt())
+}
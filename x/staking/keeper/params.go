@@ -53,6 +53,26 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// PubKeyTypes - Validator consensus pubkey types accepted when the consensus
+// params do not define them
+func (k Keeper) PubKeyTypes(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyPubKeyTypes, &res)
+	return
+}
+
+// MinDelegation - Minimum amount of bond denom tokens accepted for a delegation
+func (k Keeper) MinDelegation(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMinDelegation, &res)
+	return
+}
+
+// AllowZeroSelfDelegation - Whether validators may be created with a zero
+// minimum self delegation
+func (k Keeper) AllowZeroSelfDelegation(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyAllowZeroSelfDelegation, &res)
+	return
+}
+
 // Get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -62,6 +82,9 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
 		k.MinCommissionRate(ctx),
+		k.PubKeyTypes(ctx),
+		k.MinDelegation(ctx),
+		k.AllowZeroSelfDelegation(ctx),
 	)
 }
 
@@ -0,0 +1,101 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46.
+// The migration includes:
+//
+// - Setting the PubKeyTypes param in the paramstore, seeded from the consensus params
+// - Setting the MinDelegation param in the paramstore
+// - Setting the AllowZeroSelfDelegation param in the paramstore
+// - Raising the commission rate of validators below the MinCommissionRate param
+// - Setting a zero MaxTotalDelegation, i.e. no maximum, on all validators
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	migrateValidatorsMinCommissionRate(ctx, storeKey, cdc, paramstore)
+	migrateValidatorsMaxTotalDelegation(ctx, storeKey, cdc)
+
+	return nil
+}
+
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyPubKeyTypes, migratedPubKeyTypes(ctx))
+	paramstore.Set(ctx, types.KeyMinDelegation, types.DefaultMinDelegation)
+	paramstore.Set(ctx, types.KeyAllowZeroSelfDelegation, types.DefaultAllowZeroSelfDelegation)
+}
+
+// migratedPubKeyTypes returns the validator pubkey types accepted by the
+// consensus params, so that the chain keeps accepting the same validator keys
+// should these params be unset later on, and the default ones otherwise.
+func migratedPubKeyTypes(ctx sdk.Context) []string {
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Validator != nil && len(cp.Validator.PubKeyTypes) > 0 {
+		return cp.Validator.PubKeyTypes
+	}
+
+	return types.DefaultPubKeyTypes
+}
+
+// migrateValidatorsMinCommissionRate raises the commission rate, and the max
+// rate where needed, of every validator below the MinCommissionRate param to
+// that minimum. The raise is recorded as a commission change at the upgrade
+// block time.
+func migrateValidatorsMinCommissionRate(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) {
+	minRate := sdk.ZeroDec()
+	paramstore.GetIfExists(ctx, types.KeyMinCommissionRate, &minRate)
+	if minRate.IsZero() {
+		return
+	}
+
+	store := ctx.KVStore(storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+
+	var validators []types.Validator
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+		if validator.Commission.Rate.LT(minRate) {
+			validators = append(validators, validator)
+		}
+	}
+	iterator.Close()
+
+	for _, validator := range validators {
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		validator.Commission.UpdateTime = ctx.BlockTime()
+
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(cdc, &validator))
+	}
+}
+
+// migrateValidatorsMaxTotalDelegation sets a zero maximum total delegation on
+// every validator stored before the field was added, which leaves the
+// delegated tokens of these validators unlimited.
+func migrateValidatorsMaxTotalDelegation(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) {
+	store := ctx.KVStore(storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+
+	var validators []types.Validator
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+		if validator.MaxTotalDelegation.IsNil() {
+			validators = append(validators, validator)
+		}
+	}
+	iterator.Close()
+
+	for _, validator := range validators {
+		validator.MaxTotalDelegation = sdk.ZeroInt()
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(cdc, &validator))
+	}
+}
@@ -0,0 +1,204 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v046staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyPubKeyTypes))
+	require.False(t, paramstore.Has(ctx, types.KeyMinDelegation))
+	require.False(t, paramstore.Has(ctx, types.KeyAllowZeroSelfDelegation))
+
+	// Run migrations.
+	err := v046staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyPubKeyTypes))
+	require.True(t, paramstore.Has(ctx, types.KeyMinDelegation))
+
+	var pubKeyTypes []string
+	paramstore.Get(ctx, types.KeyPubKeyTypes, &pubKeyTypes)
+	require.Equal(t, types.DefaultPubKeyTypes, pubKeyTypes)
+
+	var minDelegation sdk.Int
+	paramstore.Get(ctx, types.KeyMinDelegation, &minDelegation)
+	require.True(t, minDelegation.IsZero())
+
+	allowZeroSelfDelegation := true
+	paramstore.Get(ctx, types.KeyAllowZeroSelfDelegation, &allowZeroSelfDelegation)
+	require.False(t, allowZeroSelfDelegation)
+}
+
+func TestStoreMigrationInitializedParamstore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	// the keeper passes its paramstore with the key table already set
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+
+	err := v046staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	require.True(t, paramstore.Has(ctx, types.KeyPubKeyTypes))
+	require.True(t, paramstore.Has(ctx, types.KeyMinDelegation))
+}
+
+func TestStoreMigrationConsensusPubKeyTypes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cp       *tmproto.ConsensusParams
+		expected []string
+	}{
+		{"no consensus params", nil, types.DefaultPubKeyTypes},
+		{"no validator params", &tmproto.ConsensusParams{}, types.DefaultPubKeyTypes},
+		{
+			"no validator pubkey types",
+			&tmproto.ConsensusParams{Validator: &tmproto.ValidatorParams{}},
+			types.DefaultPubKeyTypes,
+		},
+		{
+			"validator pubkey types",
+			&tmproto.ConsensusParams{Validator: &tmproto.ValidatorParams{PubKeyTypes: []string{"secp256k1"}}},
+			[]string{"secp256k1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encCfg := simapp.MakeTestEncodingConfig()
+			stakingKey := sdk.NewKVStoreKey("staking")
+			tStakingKey := sdk.NewTransientStoreKey("transient_test")
+			ctx := testutil.DefaultContext(stakingKey, tStakingKey).WithConsensusParams(tc.cp)
+			paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking")
+
+			err := v046staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+			require.NoError(t, err)
+
+			var pubKeyTypes []string
+			paramstore.Get(ctx, types.KeyPubKeyTypes, &pubKeyTypes)
+			require.Equal(t, tc.expected, pubKeyTypes)
+		})
+	}
+}
+
+func TestStoreMigrationMinCommissionRate(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	createTime, upgradeTime := time.Unix(1000, 0).UTC(), time.Unix(2000, 0).UTC()
+	ctx = ctx.WithBlockTime(upgradeTime)
+	store := ctx.KVStore(stakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	paramstore.Set(ctx, types.KeyMinCommissionRate, minRate)
+
+	testCases := []struct {
+		name       string
+		commission types.CommissionRates
+		expected   types.CommissionRates
+		updateTime time.Time
+	}{
+		{
+			"rate and max rate below minimum",
+			types.NewCommissionRates(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.ZeroDec()),
+			types.NewCommissionRates(minRate, minRate, sdk.ZeroDec()),
+			upgradeTime,
+		},
+		{
+			"rate below minimum",
+			types.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+			types.NewCommissionRates(minRate, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+			upgradeTime,
+		},
+		{
+			"rate at minimum",
+			types.NewCommissionRates(minRate, minRate, sdk.ZeroDec()),
+			types.NewCommissionRates(minRate, minRate, sdk.ZeroDec()),
+			createTime,
+		},
+		{
+			"rate above minimum",
+			types.NewCommissionRates(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+			types.NewCommissionRates(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+			createTime,
+		},
+	}
+
+	valAddrs := make([]sdk.ValAddress, len(testCases))
+	for i, tc := range testCases {
+		_, pk, addr := testdata.KeyTestPubAddr()
+		valAddrs[i] = sdk.ValAddress(addr)
+
+		val := teststaking.NewValidator(t, valAddrs[i], pk)
+		val.Commission = types.NewCommissionWithTime(tc.commission.Rate, tc.commission.MaxRate, tc.commission.MaxChangeRate, createTime)
+		store.Set(types.GetValidatorKey(valAddrs[i]), types.MustMarshalValidator(encCfg.Codec, &val))
+	}
+
+	// Run migrations.
+	err := v046staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val := types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(valAddrs[i])))
+			require.Equal(t, tc.expected, val.Commission.CommissionRates)
+			// a raised commission starts a new max change rate window
+			require.Equal(t, tc.updateTime, val.Commission.UpdateTime)
+		})
+	}
+}
+
+func TestStoreMigrationMaxTotalDelegation(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	store := ctx.KVStore(stakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+
+	_, pk, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	val := teststaking.NewValidator(t, valAddr, pk)
+
+	// max_total_delegation is the last field written, so removing its
+	// encoding (tag 12, length 1, "0") gives the bytes of a v0.45 validator
+	bz := types.MustMarshalValidator(encCfg.Codec, &val)
+	suffix := []byte{12<<3 | 2, 1, '0'}
+	require.Equal(t, suffix, bz[len(bz)-len(suffix):])
+	store.Set(types.GetValidatorKey(valAddr), bz[:len(bz)-len(suffix)])
+	require.True(t, types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(valAddr))).MaxTotalDelegation.IsNil())
+
+	// Run migrations.
+	err := v046staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	val = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(valAddr)))
+	require.Equal(t, sdk.ZeroInt(), val.MaxTotalDelegation)
+}
@@ -16,11 +16,14 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreateValidator = "op_weight_msg_create_validator"
-	OpWeightMsgEditValidator   = "op_weight_msg_edit_validator"
-	OpWeightMsgDelegate        = "op_weight_msg_delegate"
-	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
-	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCreateValidator           = "op_weight_msg_create_validator"
+	OpWeightMsgEditValidator             = "op_weight_msg_edit_validator"
+	OpWeightMsgDelegate                  = "op_weight_msg_delegate"
+	OpWeightMsgUndelegate                = "op_weight_msg_undelegate"
+	OpWeightMsgBeginRedelegate           = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgUndelegateShares          = "op_weight_msg_undelegate_shares"
+	OpWeightMsgBeginRedelegatePercent    = "op_weight_msg_begin_redelegate_percent"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -29,11 +32,14 @@ func WeightedOperations(
 	bk types.BankKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgCreateValidator int
-		weightMsgEditValidator   int
-		weightMsgDelegate        int
-		weightMsgUndelegate      int
-		weightMsgBeginRedelegate int
+		weightMsgCreateValidator           int
+		weightMsgEditValidator             int
+		weightMsgDelegate                  int
+		weightMsgUndelegate                int
+		weightMsgBeginRedelegate           int
+		weightMsgCancelUnbondingDelegation int
+		weightMsgUndelegateShares          int
+		weightMsgBeginRedelegatePercent    int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -66,6 +72,24 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUndelegateShares, &weightMsgUndelegateShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgUndelegateShares = simappparams.DefaultWeightMsgUndelegateShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBeginRedelegatePercent, &weightMsgBeginRedelegatePercent, nil,
+		func(_ *rand.Rand) {
+			weightMsgBeginRedelegatePercent = simappparams.DefaultWeightMsgBeginRedelegatePercent
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -87,6 +111,18 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegate(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUndelegateShares,
+			SimulateMsgUndelegateShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBeginRedelegatePercent,
+			SimulateMsgBeginRedelegatePercent(ak, bk, k),
+		),
 	}
 }
 
@@ -206,7 +242,7 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 			simtypes.RandStringOfLength(r, 10),
 		)
 
-		msg := types.NewMsgEditValidator(address, description, &newCommissionRate, nil)
+		msg := types.NewMsgEditValidator(address, description, &newCommissionRate, nil, nil)
 
 		txCtx := simulation.OperationInput{
 			R:               r,
@@ -471,3 +507,260 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// SimulateMsgCancelUnbondingDelegate generates a MsgCancelUnbondingDelegation with random values
+func SimulateMsgCancelUnbondingDelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator is not ok"), nil, nil
+		}
+
+		if validator.IsJailed() || validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "validator is jailed or has an invalid exchange rate"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		ubds := k.GetUnbondingDelegationsFromValidator(ctx, valAddr)
+		if len(ubds) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "keeper does have any unbonding delegation entries"), nil, nil
+		}
+
+		// get random unbonding delegation and entry from validator
+		ubd := ubds[r.Intn(len(ubds))]
+		delAddr, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "invalid delegator address"), nil, err
+		}
+
+		// the msg server cancels the first entry created at the given height, so
+		// the amount must be picked from that entry
+		creationHeight := ubd.Entries[r.Intn(len(ubd.Entries))].CreationHeight
+
+		var entry types.UnbondingDelegationEntry
+		for _, e := range ubd.Entries {
+			if e.CreationHeight == creationHeight {
+				entry = e
+				break
+			}
+		}
+
+		if entry.IsMature(ctx.BlockTime()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unbonding delegation entry is already mature"), nil, nil
+		}
+
+		if !entry.Balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "entry balance is not positive"), nil, nil
+		}
+
+		cancelAmt, err := simtypes.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelUnbondingDelegation, "unable to generate positive amount"), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			delAddr, valAddr, creationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		// need to retrieve the simulation account associated with the unbonding delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgUndelegateShares generates a MsgUndelegateShares with random values
+func SimulateMsgUndelegateShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegateShares, "validator is not ok"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, validator.GetOperator())
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegateShares, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegateShares, "keeper does have a max unbonding delegation entries"), nil, nil
+		}
+
+		shares := simtypes.RandomDecAmount(r, delegation.GetShares())
+		if !shares.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegateShares, "shares amount is not positive"), nil, nil
+		}
+
+		// check if the shares truncate to zero
+		if validator.TokensFromShares(shares).TruncateInt().IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegateShares, "shares truncate to zero"), nil, nil // skip
+		}
+
+		msg := types.NewMsgUndelegateShares(delAddr, valAddr, shares)
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgBeginRedelegatePercent generates a MsgBeginRedelegatePercent with random values
+func SimulateMsgBeginRedelegatePercent(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random source validator
+		srcVal, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegatePercent, "unable to pick validator"), nil, nil
+		}
+
+		srcAddr := srcVal.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, srcAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegatePercent, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from src validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegatePercent, "receveing redelegation is not allowed"), nil, nil // skip
+		}
+
+		// get random destination validator
+		destVal, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegatePercent, "unable to pick validator"), nil, nil
+		}
+
+		destAddr := destVal.GetOperator()
+		if srcAddr.Equals(destAddr) || destVal.InvalidExRate() || k.HasMaxRedelegationEntries(ctx, delAddr, srcAddr, destAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegatePercent, "checks failed"), nil, nil
+		}
+
+		percent := simtypes.RandomDecAmount(r, sdk.OneDec())
+		if !percent.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegatePercent, "percent is zero"), nil, nil
+		}
+
+		// check if the shares truncate to zero, the same way the msg server
+		// computes them
+		shares := delegation.GetShares()
+		if percent.LT(sdk.OneDec()) {
+			shares = shares.MulTruncate(percent)
+		}
+
+		if !shares.IsPositive() || srcVal.TokensFromShares(shares).TruncateInt().IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegatePercent, "shares truncate to zero"), nil, nil // skip
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegatePercent, "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgBeginRedelegatePercent(delAddr, srcAddr, destAddr, percent)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
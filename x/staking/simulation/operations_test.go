@@ -51,6 +51,9 @@ func TestWeightedOperations(t *testing.T) {
 		{simappparams.DefaultWeightMsgDelegate, types.ModuleName, types.TypeMsgDelegate},
 		{simappparams.DefaultWeightMsgUndelegate, types.ModuleName, types.TypeMsgUndelegate},
 		{simappparams.DefaultWeightMsgBeginRedelegate, types.ModuleName, types.TypeMsgBeginRedelegate},
+		{simappparams.DefaultWeightMsgCancelUnbondingDelegation, types.ModuleName, types.TypeMsgCancelUnbondingDelegation},
+		{simappparams.DefaultWeightMsgUndelegateShares, types.ModuleName, types.TypeMsgUndelegateShares},
+		{simappparams.DefaultWeightMsgBeginRedelegatePercent, types.ModuleName, types.TypeMsgBeginRedelegatePercent},
 	}
 
 	for i, w := range weightesOps {
@@ -254,6 +257,150 @@ func TestSimulateMsgBeginRedelegate(t *testing.T) {
 }
 
 // returns context and an app with updated mint keeper
+// TestSimulateMsgCancelUnbondingDelegate tests the normal scenario of a valid message of type TypeMsgCancelUnbondingDelegation.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgCancelUnbondingDelegate(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 3)
+
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// remove genesis validator account
+	accounts = accounts[1:]
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup an unbonding delegation whose tokens are held by the not bonded pool
+	delegator := accounts[1]
+	ubdTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+	ubd := types.NewUnbondingDelegation(delegator.Address, validator0.GetOperator(), app.LastBlockHeight(), blockTime.Add(2*time.Minute), ubdTokens)
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), ubdTokens))))
+
+	setupValidatorRewards(app, ctx, validator0.GetOperator())
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgCancelUnbondingDelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgCancelUnbondingDelegation
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, delegator.Address.String(), msg.DelegatorAddress)
+	require.Equal(t, validator0.GetOperator().String(), msg.ValidatorAddress)
+	require.Equal(t, app.LastBlockHeight(), msg.CreationHeight)
+	require.True(t, msg.Amount.Amount.IsPositive())
+	require.True(t, msg.Amount.Amount.LTE(ubdTokens))
+	require.Equal(t, "stake", msg.Amount.Denom)
+	require.Equal(t, types.TypeMsgCancelUnbondingDelegation, msg.Type())
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgUndelegateShares tests the normal scenario of a valid message of type TypeMsgUndelegateShares.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgUndelegateShares(t *testing.T) {
+	s := rand.NewSource(2)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 3)
+
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// remove genesis validator account
+	accounts = accounts[1:]
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup delegation
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+	delegator := accounts[1]
+	delegation := types.NewDelegation(delegator.Address, validator0.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, validator0.GetOperator(), delegator.Address, distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
+
+	setupValidatorRewards(app, ctx, validator0.GetOperator())
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgUndelegateShares(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgUndelegateShares
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, delegator.Address.String(), msg.DelegatorAddress)
+	require.Equal(t, validator0.GetOperator().String(), msg.ValidatorAddress)
+	require.True(t, msg.Shares.IsPositive())
+	require.True(t, msg.Shares.LTE(issuedShares))
+	require.Equal(t, types.TypeMsgUndelegateShares, msg.Type())
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgBeginRedelegatePercent tests the normal scenario of a valid message of type TypeMsgBeginRedelegatePercent.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgBeginRedelegatePercent(t *testing.T) {
+	s := rand.NewSource(12)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 4)
+
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// remove genesis validator account
+	accounts = accounts[1:]
+
+	// setup accounts[0] as validator0 and accounts[1] as validator1
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+	validator1 := getTestingValidator1(t, app, ctx, accounts)
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+
+	// setup accounts[2] as delegator
+	delegator := accounts[2]
+	delegation := types.NewDelegation(delegator.Address, validator1.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, validator1.GetOperator(), delegator.Address, distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
+
+	setupValidatorRewards(app, ctx, validator0.GetOperator())
+	setupValidatorRewards(app, ctx, validator1.GetOperator())
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgBeginRedelegatePercent(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgBeginRedelegatePercent
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, delegator.Address.String(), msg.DelegatorAddress)
+	require.Equal(t, validator1.GetOperator().String(), msg.ValidatorSrcAddress)
+	require.Equal(t, validator0.GetOperator().String(), msg.ValidatorDstAddress)
+	require.True(t, msg.Percent.IsPositive())
+	require.True(t, msg.Percent.LTE(sdk.OneDec()))
+	require.Equal(t, types.TypeMsgBeginRedelegatePercent, msg.Type())
+	require.Len(t, futureOperations, 0)
+}
+
 func createTestApp(t *testing.T, isCheckTx bool, r *rand.Rand, n int) (*simapp.SimApp, sdk.Context, []simtypes.Account) {
 	sdk.DefaultPowerReduction = sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
 
@@ -72,6 +72,9 @@ func (a StakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRe
 	case *MsgBeginRedelegate:
 		validatorAddress = msg.ValidatorDstAddress
 		amount = msg.Amount
+	// MsgUndelegateShares and MsgBeginRedelegatePercent are not supported, as
+	// a shares amount or a percentage cannot be checked against MaxTokens
+	// without the delegation and the validator's exchange rate
 	default:
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
 	}
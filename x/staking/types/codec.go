@@ -17,6 +17,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegate{}, "cosmos-sdk/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "cosmos-sdk/MsgUndelegate", nil)
 	cdc.RegisterConcrete(&MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation", nil)
+	cdc.RegisterConcrete(&MsgUndelegateShares{}, "cosmos-sdk/MsgUndelegateShares", nil)
+	cdc.RegisterConcrete(&MsgBeginRedelegatePercent{}, "cosmos-sdk/MsgBeginRedelegatePercent", nil)
 }
 
 // RegisterInterfaces registers the x/staking interfaces types with the interface registry
@@ -27,6 +30,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDelegate{},
 		&MsgUndelegate{},
 		&MsgBeginRedelegate{},
+		&MsgCancelUnbondingDelegation{},
+		&MsgUndelegateShares{},
+		&MsgBeginRedelegatePercent{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
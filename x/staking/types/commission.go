@@ -6,6 +6,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewCommissionRates returns an initialized validator commission rates.
@@ -50,12 +51,17 @@ func (cr CommissionRates) String() string {
 // parameters. If validation fails, an SDK error is returned.
 func (cr CommissionRates) Validate() error {
 	switch {
+	case cr.Rate.IsNil(), cr.MaxRate.IsNil(), cr.MaxChangeRate.IsNil():
+		// all rates must be set
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate, max rate and max change rate must be set")
+
 	case cr.MaxRate.IsNegative():
 		// max rate cannot be negative
 		return ErrCommissionNegative
 
 	case cr.MaxRate.GT(sdk.OneDec()):
-		// max rate cannot be greater than 1
+		// max rate cannot be greater than 1, which also bounds the rate and
+		// the max change rate below
 		return ErrCommissionHuge
 
 	case cr.Rate.IsNegative():
@@ -64,7 +70,7 @@ func (cr CommissionRates) Validate() error {
 
 	case cr.Rate.GT(cr.MaxRate):
 		// rate cannot be greater than the max rate
-		return ErrCommissionGTMaxRate
+		return sdkerrors.Wrapf(ErrCommissionGTMaxRate, "rate %s exceeds max rate %s", cr.Rate, cr.MaxRate)
 
 	case cr.MaxChangeRate.IsNegative():
 		// change rate cannot be negative
@@ -72,7 +78,9 @@ func (cr CommissionRates) Validate() error {
 
 	case cr.MaxChangeRate.GT(cr.MaxRate):
 		// change rate cannot be greater than the max rate
-		return ErrCommissionChangeRateGTMaxRate
+		return sdkerrors.Wrapf(
+			ErrCommissionChangeRateGTMaxRate, "max change rate %s exceeds max rate %s", cr.MaxChangeRate, cr.MaxRate,
+		)
 	}
 
 	return nil
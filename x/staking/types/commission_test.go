@@ -27,6 +27,12 @@ func TestCommissionValidate(t *testing.T) {
 		{types.NewCommission(sdk.OneDec(), sdk.OneDec(), sdk.MustNewDecFromStr("-1.00")), true},
 		// invalid commission; max change rate > max rate
 		{types.NewCommission(sdk.OneDec(), sdk.MustNewDecFromStr("0.75"), sdk.MustNewDecFromStr("0.90")), true},
+		// invalid commission; unset rate
+		{types.NewCommission(sdk.Dec{}, sdk.OneDec(), sdk.ZeroDec()), true},
+		// invalid commission; unset max rate
+		{types.NewCommission(sdk.ZeroDec(), sdk.Dec{}, sdk.ZeroDec()), true},
+		// invalid commission; unset max change rate
+		{types.NewCommission(sdk.ZeroDec(), sdk.OneDec(), sdk.Dec{}), true},
 		// valid commission
 		{types.NewCommission(sdk.MustNewDecFromStr("0.20"), sdk.OneDec(), sdk.MustNewDecFromStr("0.10")), false},
 	}
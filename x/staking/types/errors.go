@@ -50,4 +50,6 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
+	ErrBelowMinDelegation              = sdkerrors.Register(ModuleName, 41, "delegation amount is below the minimum delegation")
+	ErrMaxTotalDelegationExceeded      = sdkerrors.Register(ModuleName, 42, "delegation exceeds the validator's maximum total delegation")
 )
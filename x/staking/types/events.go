@@ -10,13 +10,17 @@ const (
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
 
-	AttributeKeyValidator         = "validator"
-	AttributeKeyCommissionRate    = "commission_rate"
-	AttributeKeyMinSelfDelegation = "min_self_delegation"
-	AttributeKeySrcValidator      = "source_validator"
-	AttributeKeyDstValidator      = "destination_validator"
-	AttributeKeyDelegator         = "delegator"
-	AttributeKeyCompletionTime    = "completion_time"
-	AttributeKeyNewShares         = "new_shares"
-	AttributeValueCategory        = ModuleName
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+
+	AttributeKeyValidator          = "validator"
+	AttributeKeyCommissionRate     = "commission_rate"
+	AttributeKeyMinSelfDelegation  = "min_self_delegation"
+	AttributeKeyMaxTotalDelegation = "max_total_delegation"
+	AttributeKeySrcValidator       = "source_validator"
+	AttributeKeyDstValidator       = "destination_validator"
+	AttributeKeyDelegator          = "delegator"
+	AttributeKeyCompletionTime     = "completion_time"
+	AttributeKeyNewShares          = "new_shares"
+	AttributeKeyCreationHeight     = "creation_height"
+	AttributeValueCategory         = ModuleName
 )
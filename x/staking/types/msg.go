@@ -14,6 +14,10 @@ const (
 	TypeMsgCreateValidator = "create_validator"
 	TypeMsgDelegate        = "delegate"
 	TypeMsgBeginRedelegate = "begin_redelegate"
+
+	TypeMsgCancelUnbondingDelegation = "cancel_unbond"
+	TypeMsgUndelegateShares          = "begin_unbonding_shares"
+	TypeMsgBeginRedelegatePercent    = "begin_redelegate_percent"
 )
 
 var (
@@ -24,6 +28,9 @@ var (
 	_ sdk.Msg                            = &MsgDelegate{}
 	_ sdk.Msg                            = &MsgUndelegate{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
+	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg                            = &MsgUndelegateShares{}
+	_ sdk.Msg                            = &MsgBeginRedelegatePercent{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -99,7 +106,9 @@ func (msg MsgCreateValidator) ValidateBasic() error {
 		return ErrEmptyValidatorPubKey
 	}
 
-	if !msg.Value.IsValid() || !msg.Value.Amount.IsPositive() {
+	// a zero self-delegation is only accepted together with a zero minimum
+	// self delegation, see the check against MinSelfDelegation below
+	if !msg.Value.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid delegation amount")
 	}
 
@@ -115,10 +124,12 @@ func (msg MsgCreateValidator) ValidateBasic() error {
 		return err
 	}
 
-	if !msg.MinSelfDelegation.IsPositive() {
+	// a zero minimum is only accepted by the msg server if the
+	// AllowZeroSelfDelegation param is set
+	if msg.MinSelfDelegation.IsNil() || msg.MinSelfDelegation.IsNegative() {
 		return sdkerrors.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"minimum self delegation must be a positive integer",
+			"minimum self delegation must be a non-negative integer",
 		)
 	}
 
@@ -137,12 +148,15 @@ func (msg MsgCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 
 // NewMsgEditValidator creates a new MsgEditValidator instance
 //nolint:interfacer
-func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRate *sdk.Dec, newMinSelfDelegation *sdk.Int) *MsgEditValidator {
+func NewMsgEditValidator(
+	valAddr sdk.ValAddress, description Description, newRate *sdk.Dec, newMinSelfDelegation, newMaxTotalDelegation *sdk.Int,
+) *MsgEditValidator {
 	return &MsgEditValidator{
-		Description:       description,
-		CommissionRate:    newRate,
-		ValidatorAddress:  valAddr.String(),
-		MinSelfDelegation: newMinSelfDelegation,
+		Description:        description,
+		CommissionRate:     newRate,
+		ValidatorAddress:   valAddr.String(),
+		MinSelfDelegation:  newMinSelfDelegation,
+		MaxTotalDelegation: newMaxTotalDelegation,
 	}
 }
 
@@ -181,6 +195,13 @@ func (msg MsgEditValidator) ValidateBasic() error {
 		)
 	}
 
+	if msg.MaxTotalDelegation != nil && msg.MaxTotalDelegation.IsNegative() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"maximum total delegation must be a non-negative integer",
+		)
+	}
+
 	if msg.CommissionRate != nil {
 		if msg.CommissionRate.GT(sdk.OneDec()) || msg.CommissionRate.IsNegative() {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
@@ -290,6 +311,59 @@ func (msg MsgBeginRedelegate) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgBeginRedelegatePercent creates a new MsgBeginRedelegatePercent instance.
+//nolint:interfacer
+func NewMsgBeginRedelegatePercent(
+	delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, percent sdk.Dec,
+) *MsgBeginRedelegatePercent {
+	return &MsgBeginRedelegatePercent{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorSrcAddress: valSrcAddr.String(),
+		ValidatorDstAddress: valDstAddr.String(),
+		Percent:             percent,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgBeginRedelegatePercent) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgBeginRedelegatePercent) Type() string { return TypeMsgBeginRedelegatePercent }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgBeginRedelegatePercent) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgBeginRedelegatePercent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgBeginRedelegatePercent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid source validator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination validator address: %s", err)
+	}
+
+	if msg.Percent.IsNil() || !msg.Percent.IsPositive() || msg.Percent.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"percent must be greater than 0 and at most 1",
+		)
+	}
+
+	return nil
+}
+
 // NewMsgUndelegate creates a new MsgUndelegate instance.
 //nolint:interfacer
 func NewMsgUndelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) *MsgUndelegate {
@@ -336,3 +410,105 @@ func (msg MsgUndelegate) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUndelegateShares creates a new MsgUndelegateShares instance.
+//nolint:interfacer
+func NewMsgUndelegateShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) *MsgUndelegateShares {
+	return &MsgUndelegateShares{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Shares:           shares,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUndelegateShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUndelegateShares) Type() string { return TypeMsgUndelegateShares }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUndelegateShares) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUndelegateShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUndelegateShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	return nil
+}
+
+// NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance.
+//nolint:interfacer
+func NewMsgCancelUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin) *MsgCancelUnbondingDelegation {
+	return &MsgCancelUnbondingDelegation{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+		CreationHeight:   creationHeight,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Type() string { return TypeMsgCancelUnbondingDelegation }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid amount",
+		)
+	}
+
+	if msg.CreationHeight <= 0 {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid height",
+		)
+	}
+
+	return nil
+}
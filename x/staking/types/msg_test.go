@@ -55,6 +55,7 @@ func TestMsgDecode(t *testing.T) {
 func TestMsgCreateValidator(t *testing.T) {
 	commission1 := types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 	commission2 := types.NewCommissionRates(sdk.NewDec(5), sdk.NewDec(5), sdk.NewDec(5))
+	commission3 := types.NewCommissionRates(sdk.Dec{}, sdk.ZeroDec(), sdk.ZeroDec())
 
 	tests := []struct {
 		name, moniker, identity, website, securityContact, details string
@@ -72,9 +73,12 @@ func TestMsgCreateValidator(t *testing.T) {
 		{"empty pubkey", "a", "b", "c", "d", "e", commission1, sdk.OneInt(), valAddr1, emptyPubkey, coinPos, false},
 		{"empty bond", "a", "b", "c", "d", "e", commission2, sdk.OneInt(), valAddr1, pk1, coinZero, false},
 		{"nil bond", "a", "b", "c", "d", "e", commission2, sdk.OneInt(), valAddr1, pk1, sdk.Coin{}, false},
-		{"zero min self delegation", "a", "b", "c", "d", "e", commission1, sdk.ZeroInt(), valAddr1, pk1, coinPos, false},
+		{"zero min self delegation", "a", "b", "c", "d", "e", commission1, sdk.ZeroInt(), valAddr1, pk1, coinPos, true},
+		{"zero bond and zero min self delegation", "a", "b", "c", "d", "e", commission1, sdk.ZeroInt(), valAddr1, pk1, coinZero, true},
+		{"nil min self delegation", "a", "b", "c", "d", "e", commission1, sdk.Int{}, valAddr1, pk1, coinPos, false},
 		{"negative min self delegation", "a", "b", "c", "d", "e", commission1, sdk.NewInt(-1), valAddr1, pk1, coinPos, false},
 		{"delegation less than min self delegation", "a", "b", "c", "d", "e", commission1, coinPos.Amount.Add(sdk.OneInt()), valAddr1, pk1, coinPos, false},
+		{"unset commission rate", "a", "b", "c", "d", "e", commission3, sdk.OneInt(), valAddr1, pk1, coinPos, false},
 	}
 
 	for _, tc := range tests {
@@ -91,24 +95,29 @@ func TestMsgCreateValidator(t *testing.T) {
 
 // test ValidateBasic for MsgEditValidator
 func TestMsgEditValidator(t *testing.T) {
+	zeroInt, negativeInt := sdk.ZeroInt(), sdk.NewInt(-1)
+
 	tests := []struct {
 		name, moniker, identity, website, securityContact, details string
 		validatorAddr                                              sdk.ValAddress
 		expectPass                                                 bool
 		minSelfDelegation                                          sdk.Int
+		maxTotalDelegation                                         *sdk.Int
 	}{
-		{"basic good", "a", "b", "c", "d", "e", valAddr1, true, sdk.OneInt()},
-		{"partial description", "", "", "c", "", "", valAddr1, true, sdk.OneInt()},
-		{"empty description", "", "", "", "", "", valAddr1, false, sdk.OneInt()},
-		{"empty address", "a", "b", "c", "d", "e", emptyAddr, false, sdk.OneInt()},
-		{"nil int", "a", "b", "c", "d", "e", emptyAddr, false, sdk.Int{}},
+		{"basic good", "a", "b", "c", "d", "e", valAddr1, true, sdk.OneInt(), nil},
+		{"partial description", "", "", "c", "", "", valAddr1, true, sdk.OneInt(), nil},
+		{"empty description", "", "", "", "", "", valAddr1, false, sdk.OneInt(), nil},
+		{"empty address", "a", "b", "c", "d", "e", emptyAddr, false, sdk.OneInt(), nil},
+		{"nil int", "a", "b", "c", "d", "e", emptyAddr, false, sdk.Int{}, nil},
+		{"zero max total delegation", "a", "b", "c", "d", "e", valAddr1, true, sdk.OneInt(), &zeroInt},
+		{"negative max total delegation", "a", "b", "c", "d", "e", valAddr1, false, sdk.OneInt(), &negativeInt},
 	}
 
 	for _, tc := range tests {
 		description := types.NewDescription(tc.moniker, tc.identity, tc.website, tc.securityContact, tc.details)
 		newRate := sdk.ZeroDec()
 
-		msg := types.NewMsgEditValidator(tc.validatorAddr, description, &newRate, &tc.minSelfDelegation)
+		msg := types.NewMsgEditValidator(tc.validatorAddr, description, &newRate, &tc.minSelfDelegation, tc.maxTotalDelegation)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -197,3 +206,87 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgBeginRedelegatePercent(t *testing.T) {
+	tests := []struct {
+		name             string
+		delegatorAddr    sdk.AccAddress
+		validatorSrcAddr sdk.ValAddress
+		validatorDstAddr sdk.ValAddress
+		percent          sdk.Dec
+		expectPass       bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewDecWithPrec(5, 1), true},
+		{"full delegation", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.OneDec(), true},
+		{"smallest percent", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.SmallestDec(), true},
+		{"zero percent", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.ZeroDec(), false},
+		{"negative percent", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.NewDec(-1), false},
+		{"more than the delegation", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.OneDec().Add(sdk.SmallestDec()), false},
+		{"nil percent", sdk.AccAddress(valAddr1), valAddr2, valAddr3, sdk.Dec{}, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, valAddr3, sdk.OneDec(), false},
+		{"empty source validator", sdk.AccAddress(valAddr1), emptyAddr, valAddr3, sdk.OneDec(), false},
+		{"empty destination validator", sdk.AccAddress(valAddr1), valAddr2, emptyAddr, sdk.OneDec(), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgBeginRedelegatePercent(tc.delegatorAddr, tc.validatorSrcAddr, tc.validatorDstAddr, tc.percent)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgUndelegateShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		shares        sdk.Dec
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewDecWithPrec(15, 1), true},
+		{"zero shares", sdk.AccAddress(valAddr1), valAddr2, sdk.ZeroDec(), false},
+		{"negative shares", sdk.AccAddress(valAddr1), valAddr2, sdk.NewDec(-1), false},
+		{"nil shares", sdk.AccAddress(valAddr1), valAddr2, sdk.Dec{}, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.OneDec(), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.OneDec(), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgUndelegateShares(tc.delegatorAddr, tc.validatorAddr, tc.shares)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		creationHeight int64
+		amount         sdk.Coin
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"nil amount", sdk.AccAddress(valAddr1), valAddr2, 1, sdk.Coin{}, false},
+		{"zero creation height", sdk.AccAddress(valAddr1), valAddr2, 0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.creationHeight, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
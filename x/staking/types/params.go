@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	tmtypes "github.com/tendermint/tendermint/types"
 	"sigs.k8s.io/yaml"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -35,15 +36,29 @@ const (
 var (
 	// DefaultMinCommissionRate is set to 0%
 	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultPubKeyTypes only accepts ed25519 validator consensus keys, which
+	// matches the Tendermint default consensus params
+	DefaultPubKeyTypes = []string{tmtypes.ABCIPubKeyTypeEd25519}
+
+	// DefaultMinDelegation is set to 0, which accepts delegations of any amount
+	DefaultMinDelegation = sdk.ZeroInt()
+
+	// DefaultAllowZeroSelfDelegation is set to false, which requires a positive
+	// minimum self delegation
+	DefaultAllowZeroSelfDelegation = false
 )
 
 var (
-	KeyUnbondingTime     = []byte("UnbondingTime")
-	KeyMaxValidators     = []byte("MaxValidators")
-	KeyMaxEntries        = []byte("MaxEntries")
-	KeyBondDenom         = []byte("BondDenom")
-	KeyHistoricalEntries = []byte("HistoricalEntries")
-	KeyMinCommissionRate = []byte("MinCommissionRate")
+	KeyUnbondingTime           = []byte("UnbondingTime")
+	KeyMaxValidators           = []byte("MaxValidators")
+	KeyMaxEntries              = []byte("MaxEntries")
+	KeyBondDenom               = []byte("BondDenom")
+	KeyHistoricalEntries       = []byte("HistoricalEntries")
+	KeyMinCommissionRate       = []byte("MinCommissionRate")
+	KeyPubKeyTypes             = []byte("PubKeyTypes")
+	KeyMinDelegation           = []byte("MinDelegation")
+	KeyAllowZeroSelfDelegation = []byte("AllowZeroSelfDelegation")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -54,14 +69,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec, pubKeyTypes []string, minDelegation sdk.Int, allowZeroSelfDelegation bool) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:           unbondingTime,
+		MaxValidators:           maxValidators,
+		MaxEntries:              maxEntries,
+		HistoricalEntries:       historicalEntries,
+		BondDenom:               bondDenom,
+		MinCommissionRate:       minCommissionRate,
+		PubKeyTypes:             pubKeyTypes,
+		MinDelegation:           minDelegation,
+		AllowZeroSelfDelegation: allowZeroSelfDelegation,
 	}
 }
 
@@ -74,6 +92,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyPubKeyTypes, &p.PubKeyTypes, validatePubKeyTypes),
+		paramtypes.NewParamSetPair(KeyMinDelegation, &p.MinDelegation, validateMinDelegation),
+		paramtypes.NewParamSetPair(KeyAllowZeroSelfDelegation, &p.AllowZeroSelfDelegation, validateAllowZeroSelfDelegation),
 	}
 }
 
@@ -86,6 +107,9 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultPubKeyTypes,
+		DefaultMinDelegation,
+		DefaultAllowZeroSelfDelegation,
 	)
 }
 
@@ -137,6 +161,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePubKeyTypes(p.PubKeyTypes); err != nil {
+		return err
+	}
+
+	if err := validateMinDelegation(p.MinDelegation); err != nil {
+		return err
+	}
+
+	if err := validateAllowZeroSelfDelegation(p.AllowZeroSelfDelegation); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -233,3 +269,47 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validatePubKeyTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return errors.New("pub key types cannot be empty")
+	}
+
+	for _, keyType := range v {
+		if _, ok := tmtypes.ABCIPubKeyTypesToNames[keyType]; !ok {
+			return fmt.Errorf("unknown pub key type: %s", keyType)
+		}
+	}
+
+	return nil
+}
+
+func validateMinDelegation(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum delegation cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum delegation cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateAllowZeroSelfDelegation(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
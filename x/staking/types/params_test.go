@@ -35,4 +35,29 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate pubkey types
+	params = types.DefaultParams()
+	params.PubKeyTypes = nil
+	require.Error(t, params.Validate())
+
+	params.PubKeyTypes = []string{"bls12381"}
+	require.Error(t, params.Validate())
+
+	params.PubKeyTypes = []string{"ed25519", "secp256k1"}
+	require.NoError(t, params.Validate())
+
+	// validate min delegation
+	params = types.DefaultParams()
+	params.MinDelegation = sdk.NewInt(-1)
+	require.Error(t, params.Validate())
+
+	params.MinDelegation = sdk.Int{}
+	require.Error(t, params.Validate())
+
+	params.MinDelegation = sdk.NewInt(10)
+	require.NoError(t, params.Validate())
+
+	// zero self-delegations are not allowed by default
+	require.False(t, types.DefaultParams().AllowZeroSelfDelegation)
 }
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -233,6 +234,196 @@ func (m *QueryValidatorResponse) GetValidator() Validator {
 	return Validator{}
 }
 
+// QueryValidatorExchangeRateRequest is request type for the
+// Query/ValidatorExchangeRate RPC method
+type QueryValidatorExchangeRateRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorExchangeRateRequest) Reset()         { *m = QueryValidatorExchangeRateRequest{} }
+func (m *QueryValidatorExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorExchangeRateRequest) ProtoMessage()    {}
+func (*QueryValidatorExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{4}
+}
+func (m *QueryValidatorExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorExchangeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorExchangeRateRequest.Merge(m, src)
+}
+func (m *QueryValidatorExchangeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorExchangeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorExchangeRateRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorExchangeRateRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryValidatorExchangeRateResponse is response type for the
+// Query/ValidatorExchangeRate RPC method
+type QueryValidatorExchangeRateResponse struct {
+	// exchange_rate defines the amount of tokens one delegator share is worth. It
+	// is one for a validator without delegator shares, which is the rate new
+	// shares are issued at.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+}
+
+func (m *QueryValidatorExchangeRateResponse) Reset()         { *m = QueryValidatorExchangeRateResponse{} }
+func (m *QueryValidatorExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorExchangeRateResponse) ProtoMessage()    {}
+func (*QueryValidatorExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{5}
+}
+func (m *QueryValidatorExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorExchangeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorExchangeRateResponse.Merge(m, src)
+}
+func (m *QueryValidatorExchangeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorExchangeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorExchangeRateResponse proto.InternalMessageInfo
+
+// QueryValidatorSelfDelegationStatusRequest is request type for the
+// Query/ValidatorSelfDelegationStatus RPC method
+type QueryValidatorSelfDelegationStatusRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorSelfDelegationStatusRequest) Reset() {
+	*m = QueryValidatorSelfDelegationStatusRequest{}
+}
+func (m *QueryValidatorSelfDelegationStatusRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorSelfDelegationStatusRequest) ProtoMessage() {}
+func (*QueryValidatorSelfDelegationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{6}
+}
+func (m *QueryValidatorSelfDelegationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSelfDelegationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSelfDelegationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorSelfDelegationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSelfDelegationStatusRequest.Merge(m, src)
+}
+func (m *QueryValidatorSelfDelegationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSelfDelegationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSelfDelegationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorSelfDelegationStatusRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorSelfDelegationStatusRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryValidatorSelfDelegationStatusResponse is response type for the
+// Query/ValidatorSelfDelegationStatus RPC method
+type QueryValidatorSelfDelegationStatusResponse struct {
+	// self_bonded_tokens defines the tokens the operator's self-delegation is
+	// worth, truncated the same way as when it is checked on undelegation.
+	SelfBondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=self_bonded_tokens,json=selfBondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"self_bonded_tokens"`
+	// min_self_delegation defines the validator's minimum self delegation.
+	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// shortfall defines the tokens the operator has to self-delegate to reach
+	// the minimum self delegation, zero if it is already met.
+	Shortfall github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=shortfall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shortfall"`
+}
+
+func (m *QueryValidatorSelfDelegationStatusResponse) Reset() {
+	*m = QueryValidatorSelfDelegationStatusResponse{}
+}
+func (m *QueryValidatorSelfDelegationStatusResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorSelfDelegationStatusResponse) ProtoMessage() {}
+func (*QueryValidatorSelfDelegationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{7}
+}
+func (m *QueryValidatorSelfDelegationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSelfDelegationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSelfDelegationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorSelfDelegationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSelfDelegationStatusResponse.Merge(m, src)
+}
+func (m *QueryValidatorSelfDelegationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSelfDelegationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSelfDelegationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorSelfDelegationStatusResponse proto.InternalMessageInfo
+
 // QueryValidatorDelegationsRequest is request type for the
 // Query/ValidatorDelegations RPC method
 type QueryValidatorDelegationsRequest struct {
@@ -246,7 +437,7 @@ func (m *QueryValidatorDelegationsRequest) Reset()         { *m = QueryValidator
 func (m *QueryValidatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorDelegationsRequest) ProtoMessage()    {}
 func (*QueryValidatorDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{4}
+	return fileDescriptor_f270127f442bbcd8, []int{8}
 }
 func (m *QueryValidatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,7 +492,7 @@ func (m *QueryValidatorDelegationsResponse) Reset()         { *m = QueryValidato
 func (m *QueryValidatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorDelegationsResponse) ProtoMessage()    {}
 func (*QueryValidatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{5}
+	return fileDescriptor_f270127f442bbcd8, []int{9}
 }
 func (m *QueryValidatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +552,7 @@ func (m *QueryValidatorUnbondingDelegationsRequest) String() string {
 }
 func (*QueryValidatorUnbondingDelegationsRequest) ProtoMessage() {}
 func (*QueryValidatorUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{6}
+	return fileDescriptor_f270127f442bbcd8, []int{10}
 }
 func (m *QueryValidatorUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +611,7 @@ func (m *QueryValidatorUnbondingDelegationsResponse) String() string {
 }
 func (*QueryValidatorUnbondingDelegationsResponse) ProtoMessage() {}
 func (*QueryValidatorUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{7}
+	return fileDescriptor_f270127f442bbcd8, []int{11}
 }
 func (m *QueryValidatorUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +666,7 @@ func (m *QueryDelegationRequest) Reset()         { *m = QueryDelegationRequest{}
 func (m *QueryDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRequest) ProtoMessage()    {}
 func (*QueryDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{8}
+	return fileDescriptor_f270127f442bbcd8, []int{12}
 }
 func (m *QueryDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +705,7 @@ func (m *QueryDelegationResponse) Reset()         { *m = QueryDelegationResponse
 func (m *QueryDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationResponse) ProtoMessage()    {}
 func (*QueryDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{9}
+	return fileDescriptor_f270127f442bbcd8, []int{13}
 }
 func (m *QueryDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +754,7 @@ func (m *QueryUnbondingDelegationRequest) Reset()         { *m = QueryUnbondingD
 func (m *QueryUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnbondingDelegationRequest) ProtoMessage()    {}
 func (*QueryUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{10}
+	return fileDescriptor_f270127f442bbcd8, []int{14}
 }
 func (m *QueryUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +794,7 @@ func (m *QueryUnbondingDelegationResponse) Reset()         { *m = QueryUnbonding
 func (m *QueryUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnbondingDelegationResponse) ProtoMessage()    {}
 func (*QueryUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{11}
+	return fileDescriptor_f270127f442bbcd8, []int{15}
 }
 func (m *QueryUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,7 +843,7 @@ func (m *QueryDelegatorDelegationsRequest) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDelegationsRequest) ProtoMessage()    {}
 func (*QueryDelegatorDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{12}
+	return fileDescriptor_f270127f442bbcd8, []int{16}
 }
 func (m *QueryDelegatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +885,7 @@ func (m *QueryDelegatorDelegationsResponse) Reset()         { *m = QueryDelegato
 func (m *QueryDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDelegationsResponse) ProtoMessage()    {}
 func (*QueryDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{13}
+	return fileDescriptor_f270127f442bbcd8, []int{17}
 }
 func (m *QueryDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +945,7 @@ func (m *QueryDelegatorUnbondingDelegationsRequest) String() string {
 }
 func (*QueryDelegatorUnbondingDelegationsRequest) ProtoMessage() {}
 func (*QueryDelegatorUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{14}
+	return fileDescriptor_f270127f442bbcd8, []int{18}
 }
 func (m *QueryDelegatorUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +990,7 @@ func (m *QueryDelegatorUnbondingDelegationsResponse) String() string {
 }
 func (*QueryDelegatorUnbondingDelegationsResponse) ProtoMessage() {}
 func (*QueryDelegatorUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{15}
+	return fileDescriptor_f270127f442bbcd8, []int{19}
 }
 func (m *QueryDelegatorUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +1050,7 @@ func (m *QueryRedelegationsRequest) Reset()         { *m = QueryRedelegationsReq
 func (m *QueryRedelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRedelegationsRequest) ProtoMessage()    {}
 func (*QueryRedelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{16}
+	return fileDescriptor_f270127f442bbcd8, []int{20}
 }
 func (m *QueryRedelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,7 +1091,7 @@ func (m *QueryRedelegationsResponse) Reset()         { *m = QueryRedelegationsRe
 func (m *QueryRedelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRedelegationsResponse) ProtoMessage()    {}
 func (*QueryRedelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{17}
+	return fileDescriptor_f270127f442bbcd8, []int{21}
 }
 func (m *QueryRedelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,7 +1147,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{18}
+	return fileDescriptor_f270127f442bbcd8, []int{22}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,7 +1189,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{19}
+	return fileDescriptor_f270127f442bbcd8, []int{23}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1245,7 @@ func (m *QueryDelegatorValidatorRequest) Reset()         { *m = QueryDelegatorVa
 func (m *QueryDelegatorValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{20}
+	return fileDescriptor_f270127f442bbcd8, []int{24}
 }
 func (m *QueryDelegatorValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,7 +1285,7 @@ func (m *QueryDelegatorValidatorResponse) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{21}
+	return fileDescriptor_f270127f442bbcd8, []int{25}
 }
 func (m *QueryDelegatorValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1141,7 +1332,7 @@ func (m *QueryHistoricalInfoRequest) Reset()         { *m = QueryHistoricalInfoR
 func (m *QueryHistoricalInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalInfoRequest) ProtoMessage()    {}
 func (*QueryHistoricalInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{22}
+	return fileDescriptor_f270127f442bbcd8, []int{26}
 }
 func (m *QueryHistoricalInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1188,7 +1379,7 @@ func (m *QueryHistoricalInfoResponse) Reset()         { *m = QueryHistoricalInfo
 func (m *QueryHistoricalInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalInfoResponse) ProtoMessage()    {}
 func (*QueryHistoricalInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{23}
+	return fileDescriptor_f270127f442bbcd8, []int{27}
 }
 func (m *QueryHistoricalInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,7 +1423,7 @@ func (m *QueryPoolRequest) Reset()         { *m = QueryPoolRequest{} }
 func (m *QueryPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolRequest) ProtoMessage()    {}
 func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{24}
+	return fileDescriptor_f270127f442bbcd8, []int{28}
 }
 func (m *QueryPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1462,7 @@ func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
 func (m *QueryPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolResponse) ProtoMessage()    {}
 func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{25}
+	return fileDescriptor_f270127f442bbcd8, []int{29}
 }
 func (m *QueryPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,7 +1506,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{26}
+	return fileDescriptor_f270127f442bbcd8, []int{30}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1354,7 +1545,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{27}
+	return fileDescriptor_f270127f442bbcd8, []int{31}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,6 +1586,10 @@ func init() {
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
 	proto.RegisterType((*QueryValidatorRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorRequest")
 	proto.RegisterType((*QueryValidatorResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorResponse")
+	proto.RegisterType((*QueryValidatorExchangeRateRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorExchangeRateRequest")
+	proto.RegisterType((*QueryValidatorExchangeRateResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorExchangeRateResponse")
+	proto.RegisterType((*QueryValidatorSelfDelegationStatusRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorSelfDelegationStatusRequest")
+	proto.RegisterType((*QueryValidatorSelfDelegationStatusResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorSelfDelegationStatusResponse")
 	proto.RegisterType((*QueryValidatorDelegationsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorDelegationsRequest")
 	proto.RegisterType((*QueryValidatorDelegationsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorDelegationsResponse")
 	proto.RegisterType((*QueryValidatorUnbondingDelegationsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorUnbondingDelegationsRequest")
@@ -1426,91 +1621,105 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0x1b, 0x55,
-	0x17, 0xf7, 0x4d, 0xf3, 0x45, 0x5f, 0x4f, 0xd5, 0xaa, 0x5c, 0xbb, 0x21, 0x9d, 0x16, 0x3b, 0x1d,
-	0x55, 0x21, 0x4d, 0x1b, 0x0f, 0x49, 0x4a, 0x1a, 0x4a, 0x45, 0x49, 0x28, 0x29, 0x51, 0x17, 0x24,
-	0xae, 0x08, 0x05, 0x16, 0xd6, 0xd8, 0x33, 0x1d, 0x8f, 0xea, 0xcc, 0xb8, 0x73, 0x27, 0x51, 0x4b,
-	0x94, 0x05, 0xac, 0x60, 0x87, 0xc4, 0x8a, 0x5d, 0x17, 0x48, 0x48, 0x3c, 0x56, 0x84, 0x6d, 0x25,
-	0x56, 0x94, 0x5d, 0x78, 0x2c, 0x60, 0x53, 0x50, 0xc2, 0xa2, 0xe2, 0x1f, 0x40, 0xec, 0x90, 0xef,
-	0x9c, 0x19, 0x8f, 0x33, 0x4f, 0x3b, 0x8e, 0x94, 0xae, 0x1a, 0x5f, 0x9f, 0xc7, 0xef, 0x77, 0x1e,
-	0xf7, 0x9e, 0xe3, 0x82, 0x58, 0x35, 0xd9, 0x8a, 0xc9, 0x24, 0x66, 0xcb, 0x77, 0x74, 0x43, 0x93,
-	0xd6, 0x26, 0x2a, 0xaa, 0x2d, 0x4f, 0x48, 0x77, 0x57, 0x55, 0xeb, 0x7e, 0xb1, 0x61, 0x99, 0xb6,
-	0x49, 0x07, 0x1d, 0x99, 0x22, 0xca, 0x14, 0x51, 0x46, 0x18, 0x43, 0xdd, 0x8a, 0xcc, 0x54, 0x47,
-	0xc1, 0x53, 0x6f, 0xc8, 0x9a, 0x6e, 0xc8, 0xb6, 0x6e, 0x1a, 0x8e, 0x0d, 0x21, 0xa7, 0x99, 0x9a,
-	0xc9, 0xff, 0x94, 0x9a, 0x7f, 0xe1, 0xe9, 0x69, 0xcd, 0x34, 0xb5, 0xba, 0x2a, 0xc9, 0x0d, 0x5d,
-	0x92, 0x0d, 0xc3, 0xb4, 0xb9, 0x0a, 0xc3, 0x6f, 0xcf, 0x46, 0x60, 0x73, 0x71, 0x38, 0x52, 0x27,
-	0x1d, 0xa9, 0xb2, 0x63, 0x1c, 0xa1, 0xf2, 0x0f, 0xe2, 0x3d, 0x18, 0x5c, 0x6a, 0xc2, 0x5a, 0x96,
-	0xeb, 0xba, 0x22, 0xdb, 0xa6, 0xc5, 0x4a, 0xea, 0xdd, 0x55, 0x95, 0xd9, 0x74, 0x10, 0x06, 0x98,
-	0x2d, 0xdb, 0xab, 0x6c, 0x88, 0x0c, 0x93, 0xd1, 0xc3, 0x25, 0xfc, 0x44, 0xe7, 0x01, 0x5a, 0xd0,
-	0x87, 0xfa, 0x86, 0xc9, 0xe8, 0x91, 0xc9, 0x91, 0x22, 0x1a, 0x6d, 0xf2, 0x2c, 0x3a, 0x81, 0x41,
-	0x28, 0xc5, 0x45, 0x59, 0x53, 0xd1, 0x66, 0xc9, 0xa7, 0x29, 0x7e, 0x45, 0xe0, 0xd9, 0x80, 0x6b,
-	0xd6, 0x30, 0x0d, 0xa6, 0xd2, 0xeb, 0x00, 0x6b, 0xde, 0xe9, 0x10, 0x19, 0x3e, 0x34, 0x7a, 0x64,
-	0xf2, 0x4c, 0x31, 0x3c, 0xc6, 0x45, 0x4f, 0x7f, 0xae, 0xff, 0xd1, 0xe3, 0x42, 0xa6, 0xe4, 0x53,
-	0x6d, 0x1a, 0x0a, 0x80, 0x7d, 0x3e, 0x11, 0xac, 0x83, 0xa2, 0x0d, 0xed, 0x2d, 0x38, 0xd1, 0x0e,
-	0xd6, 0x0d, 0xd3, 0x55, 0x38, 0xe6, 0xf9, 0x2b, 0xcb, 0x8a, 0x62, 0x39, 0xe1, 0x9a, 0x1b, 0xfa,
-	0x79, 0x73, 0x3c, 0x87, 0x8e, 0x66, 0x15, 0xc5, 0x52, 0x19, 0xbb, 0x69, 0x5b, 0xba, 0xa1, 0x95,
-	0x8e, 0x7a, 0xf2, 0xcd, 0x73, 0xb1, 0xbc, 0x3b, 0x03, 0x5e, 0x14, 0x5e, 0x87, 0xc3, 0x9e, 0x28,
-	0xb7, 0xda, 0x41, 0x10, 0x5a, 0x9a, 0xcd, 0x40, 0x0f, 0xb7, 0x7b, 0xb8, 0xa6, 0xd6, 0x55, 0xcd,
-	0xa9, 0xa3, 0x5e, 0xd1, 0xe8, 0x59, 0x59, 0x3c, 0x21, 0x70, 0x26, 0x06, 0x2d, 0x86, 0xe6, 0x7d,
-	0xc8, 0x29, 0xde, 0x71, 0xd9, 0xc2, 0x63, 0xb7, 0x54, 0xc6, 0xa2, 0xa2, 0xd4, 0x32, 0xe5, 0x5a,
-	0x9a, 0x3b, 0xd5, 0x0c, 0xd7, 0x97, 0x7f, 0x14, 0xb2, 0xc1, 0xef, 0x58, 0x29, 0xab, 0x04, 0x0f,
-	0x7b, 0x57, 0x53, 0x9b, 0x04, 0xce, 0xb5, 0x53, 0x7d, 0xcb, 0xa8, 0x98, 0x86, 0xa2, 0x1b, 0xda,
-	0x41, 0xce, 0xd0, 0xef, 0x04, 0xc6, 0xd2, 0xc0, 0xc6, 0x54, 0x55, 0x20, 0xbb, 0xea, 0x7e, 0x1f,
-	0xc8, 0xd4, 0xf9, 0xa8, 0x4c, 0x85, 0x98, 0xc4, 0xca, 0xa6, 0x9e, 0xb5, 0x7d, 0x48, 0xc9, 0xe7,
-	0x04, 0xbb, 0xd1, 0x5f, 0x0d, 0x5e, 0xfc, 0xb1, 0x1a, 0x52, 0xc7, 0xdf, 0x93, 0xe7, 0xf1, 0x0f,
-	0x26, 0xb0, 0xaf, 0xa3, 0x04, 0x5e, 0xfe, 0xff, 0x47, 0x0f, 0x0a, 0x99, 0x27, 0x0f, 0x0a, 0x19,
-	0x71, 0x0d, 0xaf, 0xce, 0x60, 0xcd, 0xd2, 0xf7, 0x20, 0x1b, 0xd2, 0x19, 0x78, 0x7d, 0x74, 0xd0,
-	0x18, 0x25, 0x1a, 0xac, 0x7d, 0xf1, 0x1b, 0x02, 0x05, 0xee, 0x38, 0x24, 0x3d, 0x07, 0x31, 0x4e,
-	0x2b, 0x78, 0xf3, 0x85, 0xc2, 0xc5, 0x80, 0x2d, 0xc0, 0x80, 0x53, 0x51, 0x18, 0xa3, 0x2e, 0x4a,
-	0x12, 0x0d, 0x88, 0xdf, 0xb9, 0x37, 0xed, 0x35, 0x97, 0x50, 0x78, 0x1f, 0xef, 0x2d, 0x3e, 0x3d,
-	0xea, 0x63, 0x5f, 0x98, 0x7e, 0x72, 0xef, 0xdc, 0x70, 0xdc, 0x18, 0xa8, 0x6a, 0xcf, 0xee, 0x5c,
-	0x27, 0x6a, 0xfb, 0x7b, 0xb9, 0x3e, 0x74, 0x2f, 0x57, 0x8f, 0x53, 0xc2, 0xe5, 0x7a, 0xd0, 0x92,
-	0xe2, 0x5d, 0xb3, 0x09, 0x04, 0x9e, 0xc6, 0x6b, 0xf6, 0x61, 0x1f, 0x9c, 0xe4, 0xdc, 0x4a, 0xaa,
-	0xb2, 0x2f, 0xc9, 0xa0, 0xcc, 0xaa, 0x96, 0x3b, 0xbc, 0x45, 0x8e, 0x33, 0xab, 0xba, 0xbc, 0xeb,
-	0xc5, 0xa4, 0x0a, 0xb3, 0x77, 0xdb, 0x39, 0x94, 0x64, 0x47, 0x61, 0xf6, 0x72, 0xcc, 0xcb, 0xdb,
-	0xdf, 0x83, 0xe2, 0xd8, 0x22, 0x20, 0x84, 0x05, 0x10, 0x8b, 0x41, 0x87, 0x41, 0x4b, 0x8d, 0x69,
-	0xd6, 0x0b, 0x51, 0xf5, 0xe0, 0x37, 0xb7, 0xab, 0x5d, 0x4f, 0x58, 0xea, 0x7e, 0x4f, 0x43, 0x85,
-	0xf6, 0x7a, 0x0f, 0xee, 0x24, 0x07, 0xb0, 0x4d, 0x37, 0x03, 0x77, 0xfe, 0x53, 0xb1, 0xcf, 0x7c,
-	0x4d, 0x20, 0x1f, 0x01, 0xfb, 0x20, 0x3e, 0xe4, 0xb5, 0xc8, 0xda, 0xe8, 0xf5, 0xb6, 0x74, 0x11,
-	0x1b, 0xeb, 0x0d, 0x9d, 0xd9, 0xa6, 0xa5, 0x57, 0xe5, 0xfa, 0x82, 0x71, 0xdb, 0xf4, 0x2d, 0xc5,
-	0x35, 0x55, 0xd7, 0x6a, 0x36, 0xf7, 0x70, 0xa8, 0x84, 0x9f, 0xc4, 0x77, 0xe0, 0x54, 0xa8, 0x16,
-	0x62, 0xbb, 0x0c, 0xfd, 0x35, 0x9d, 0xd9, 0x08, 0x6b, 0x24, 0x0a, 0xd6, 0x2e, 0x6d, 0xae, 0x23,
-	0x52, 0x38, 0xce, 0x4d, 0x2f, 0x9a, 0x66, 0x1d, 0x61, 0x88, 0x37, 0xe0, 0x19, 0xdf, 0x19, 0x3a,
-	0x99, 0x86, 0xfe, 0x86, 0x69, 0xd6, 0xd1, 0xc9, 0xe9, 0x28, 0x27, 0x4d, 0x1d, 0xa4, 0xcd, 0xe5,
-	0xc5, 0x1c, 0x50, 0xc7, 0x98, 0x6c, 0xc9, 0x2b, 0x6e, 0xab, 0x89, 0x37, 0x21, 0xdb, 0x76, 0x8a,
-	0x4e, 0xae, 0xc0, 0x40, 0x83, 0x9f, 0xa0, 0x9b, 0x7c, 0xa4, 0x1b, 0x2e, 0xe5, 0x0e, 0x48, 0x8e,
-	0xce, 0xe4, 0xdf, 0x27, 0xe0, 0x7f, 0xdc, 0x2a, 0xfd, 0x8c, 0x00, 0xb4, 0x1a, 0x85, 0x16, 0xa3,
-	0xcc, 0x84, 0xff, 0x38, 0x21, 0x48, 0xa9, 0xe5, 0x71, 0x72, 0x1d, 0xfb, 0xf0, 0x97, 0xbf, 0x3e,
-	0xed, 0x3b, 0x4b, 0x45, 0x29, 0xe2, 0x17, 0x13, 0x5f, 0x93, 0x7d, 0x41, 0xe0, 0xb0, 0x67, 0x82,
-	0x8e, 0xa7, 0x73, 0xe5, 0x22, 0x2b, 0xa6, 0x15, 0x47, 0x60, 0x2f, 0x73, 0x60, 0x2f, 0xd2, 0xa9,
-	0x64, 0x60, 0xd2, 0x7a, 0x7b, 0x3b, 0x6d, 0xd0, 0x5f, 0x09, 0xe4, 0xc2, 0xf6, 0x64, 0x3a, 0x93,
-	0x0e, 0x45, 0x70, 0x12, 0x12, 0x5e, 0xea, 0x42, 0x13, 0xa9, 0x5c, 0xe7, 0x54, 0x66, 0xe9, 0xd5,
-	0x2e, 0xa8, 0x48, 0xbe, 0x67, 0x8c, 0xfe, 0x4b, 0xe0, 0xb9, 0xd8, 0xe5, 0x92, 0xce, 0xa6, 0x43,
-	0x19, 0x33, 0xf2, 0x09, 0x73, 0x7b, 0x31, 0x81, 0x8c, 0x97, 0x38, 0xe3, 0x1b, 0x74, 0xa1, 0x1b,
-	0xc6, 0xad, 0x71, 0xcd, 0xcf, 0xfd, 0x07, 0x02, 0xd0, 0x72, 0x95, 0xd0, 0x18, 0x81, 0xed, 0x2b,
-	0xa1, 0x31, 0x82, 0xb3, 0xb8, 0x78, 0x8b, 0x53, 0x28, 0xd1, 0xc5, 0x3d, 0x26, 0x4d, 0x5a, 0x6f,
-	0x7f, 0x2c, 0x36, 0xe8, 0x3f, 0x04, 0xb2, 0x21, 0xd1, 0xa3, 0x97, 0x62, 0x21, 0x46, 0x6f, 0x96,
-	0xc2, 0x4c, 0xe7, 0x8a, 0x48, 0x72, 0x85, 0x93, 0xd4, 0xa8, 0xda, 0x6b, 0x92, 0xa1, 0x49, 0xa4,
-	0x3f, 0x12, 0xc8, 0x85, 0xad, 0x52, 0x09, 0x6d, 0x19, 0xb3, 0x35, 0x26, 0xb4, 0x65, 0xdc, 0xde,
-	0x26, 0x5e, 0xe1, 0xe4, 0xa7, 0xe9, 0xc5, 0x28, 0xf2, 0xb1, 0x59, 0x6c, 0xf6, 0x62, 0xec, 0x06,
-	0x92, 0xd0, 0x8b, 0x69, 0xd6, 0xaf, 0x84, 0x5e, 0x4c, 0xb5, 0x00, 0x25, 0xf7, 0xa2, 0xc7, 0x2c,
-	0x65, 0x1a, 0x19, 0xfd, 0x9e, 0xc0, 0xd1, 0xb6, 0x01, 0x9b, 0x4e, 0xc4, 0x02, 0x0d, 0xdb, 0x66,
-	0x84, 0xc9, 0x4e, 0x54, 0x90, 0xcb, 0x02, 0xe7, 0xf2, 0x1a, 0x9d, 0xed, 0x86, 0x8b, 0xd5, 0x86,
-	0x78, 0x8b, 0x40, 0x36, 0x64, 0x34, 0x4d, 0xe8, 0xc2, 0xe8, 0x19, 0x5c, 0x98, 0xe9, 0x5c, 0x11,
-	0x59, 0xcd, 0x73, 0x56, 0xaf, 0xd2, 0x57, 0xba, 0x61, 0xe5, 0x7b, 0x9f, 0x1f, 0x13, 0xa0, 0x41,
-	0x3f, 0x74, 0xba, 0x43, 0x60, 0x2e, 0xa1, 0x4b, 0x1d, 0xeb, 0x21, 0x9f, 0xb7, 0x39, 0x9f, 0x25,
-	0xfa, 0xe6, 0xde, 0xf8, 0x04, 0x9f, 0xf5, 0x6f, 0x09, 0x1c, 0x6b, 0x9f, 0x05, 0x69, 0x7c, 0x15,
-	0x85, 0x0e, 0xab, 0xc2, 0x54, 0x47, 0x3a, 0x48, 0x6a, 0x86, 0x93, 0x9a, 0xa4, 0x2f, 0x44, 0x91,
-	0xaa, 0x79, 0x7a, 0x65, 0xdd, 0xb8, 0x6d, 0x4a, 0xeb, 0xce, 0x08, 0xbc, 0x41, 0x3f, 0x20, 0xd0,
-	0xdf, 0x1c, 0x2e, 0xe9, 0x68, 0xac, 0x5f, 0xdf, 0x1c, 0x2b, 0x9c, 0x4b, 0x21, 0x89, 0xb8, 0xce,
-	0x72, 0x5c, 0x79, 0x7a, 0x3a, 0x0a, 0x57, 0x73, 0x96, 0xa5, 0x1f, 0x13, 0x18, 0x70, 0x26, 0x4f,
-	0x3a, 0x16, 0x6f, 0xdb, 0x3f, 0xec, 0x0a, 0xe7, 0x53, 0xc9, 0x22, 0x92, 0x11, 0x8e, 0x64, 0x98,
-	0xe6, 0x23, 0x91, 0x38, 0xa3, 0xef, 0xfc, 0xa3, 0xed, 0x3c, 0xd9, 0xda, 0xce, 0x93, 0x3f, 0xb7,
-	0xf3, 0xe4, 0x93, 0x9d, 0x7c, 0x66, 0x6b, 0x27, 0x9f, 0xf9, 0x6d, 0x27, 0x9f, 0x79, 0xf7, 0x82,
-	0xa6, 0xdb, 0xb5, 0xd5, 0x4a, 0xb1, 0x6a, 0xae, 0xb8, 0x36, 0x9c, 0x7f, 0xc6, 0x99, 0x72, 0x47,
-	0xba, 0xe7, 0x19, 0xb4, 0xef, 0x37, 0x54, 0x56, 0x19, 0xe0, 0xff, 0x53, 0x37, 0xf5, 0x5f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xbf, 0x31, 0x66, 0xa3, 0x88, 0x1c, 0x00, 0x00,
+	// 1564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x73, 0x14, 0xd5,
+	0x16, 0xcf, 0x4d, 0xf2, 0x52, 0x2f, 0x87, 0x07, 0x05, 0x77, 0x42, 0x08, 0x0d, 0x6f, 0x12, 0xba,
+	0x28, 0x0c, 0x81, 0x4c, 0x4b, 0x40, 0x08, 0x48, 0x89, 0x89, 0x01, 0x8c, 0x58, 0x25, 0x74, 0x14,
+	0x11, 0x17, 0x53, 0x9d, 0xe9, 0x9b, 0x9e, 0x96, 0x99, 0xee, 0xa1, 0xbb, 0x43, 0x81, 0x14, 0x0b,
+	0xdd, 0xa8, 0x3b, 0xab, 0x5c, 0xb9, 0x63, 0x61, 0x95, 0x55, 0x7e, 0xac, 0x8c, 0x5b, 0xaa, 0x2c,
+	0x17, 0xe2, 0x2e, 0xa2, 0x0b, 0x71, 0x81, 0x56, 0x70, 0xc1, 0x7f, 0x60, 0xe9, 0xca, 0xea, 0xdb,
+	0x67, 0x7a, 0xba, 0xa7, 0x3f, 0xa6, 0x67, 0x32, 0xa9, 0x0a, 0xab, 0xcc, 0xdc, 0xbe, 0xe7, 0x9c,
+	0xdf, 0xef, 0x7c, 0xf5, 0x3d, 0x77, 0x02, 0x62, 0xc9, 0xb4, 0xab, 0xa6, 0x2d, 0xd9, 0x8e, 0x72,
+	0x4d, 0x37, 0x34, 0xe9, 0xc6, 0x91, 0x45, 0xe6, 0x28, 0x47, 0xa4, 0xeb, 0xcb, 0xcc, 0xba, 0x55,
+	0xa8, 0x59, 0xa6, 0x63, 0xd2, 0x61, 0x6f, 0x4f, 0x01, 0xf7, 0x14, 0x70, 0x8f, 0x30, 0x81, 0xb2,
+	0x8b, 0x8a, 0xcd, 0x3c, 0x01, 0x5f, 0xbc, 0xa6, 0x68, 0xba, 0xa1, 0x38, 0xba, 0x69, 0x78, 0x3a,
+	0x84, 0x21, 0xcd, 0xd4, 0x4c, 0xfe, 0x51, 0x72, 0x3f, 0xe1, 0xea, 0x5e, 0xcd, 0x34, 0xb5, 0x0a,
+	0x93, 0x94, 0x9a, 0x2e, 0x29, 0x86, 0x61, 0x3a, 0x5c, 0xc4, 0xc6, 0xa7, 0xfb, 0x13, 0xb0, 0xd5,
+	0x71, 0x78, 0xbb, 0x76, 0x7b, 0xbb, 0x8a, 0x9e, 0x72, 0x84, 0xca, 0xbf, 0x88, 0x37, 0x61, 0xf8,
+	0x92, 0x0b, 0xeb, 0xb2, 0x52, 0xd1, 0x55, 0xc5, 0x31, 0x2d, 0x5b, 0x66, 0xd7, 0x97, 0x99, 0xed,
+	0xd0, 0x61, 0x18, 0xb0, 0x1d, 0xc5, 0x59, 0xb6, 0x47, 0xc8, 0x18, 0x19, 0x1f, 0x94, 0xf1, 0x1b,
+	0x3d, 0x07, 0xd0, 0x80, 0x3e, 0xd2, 0x3b, 0x46, 0xc6, 0xb7, 0x4c, 0x1d, 0x28, 0xa0, 0x52, 0x97,
+	0x67, 0xc1, 0x73, 0x0c, 0x42, 0x29, 0x5c, 0x54, 0x34, 0x86, 0x3a, 0xe5, 0x80, 0xa4, 0xf8, 0x25,
+	0x81, 0x5d, 0x11, 0xd3, 0x76, 0xcd, 0x34, 0x6c, 0x46, 0xcf, 0x03, 0xdc, 0xf0, 0x57, 0x47, 0xc8,
+	0x58, 0xdf, 0xf8, 0x96, 0xa9, 0x7d, 0x85, 0x78, 0x1f, 0x17, 0x7c, 0xf9, 0xd9, 0xfe, 0xfb, 0x8f,
+	0x46, 0x7b, 0xe4, 0x80, 0xa8, 0xab, 0x28, 0x02, 0xf6, 0x99, 0x96, 0x60, 0x3d, 0x14, 0x21, 0xb4,
+	0x57, 0x60, 0x67, 0x18, 0x6c, 0xdd, 0x4d, 0x67, 0x60, 0x9b, 0x6f, 0xaf, 0xa8, 0xa8, 0xaa, 0xe5,
+	0xb9, 0x6b, 0x76, 0xe4, 0xc1, 0xca, 0xe4, 0x10, 0x1a, 0x9a, 0x51, 0x55, 0x8b, 0xd9, 0xf6, 0x82,
+	0x63, 0xe9, 0x86, 0x26, 0x6f, 0xf5, 0xf7, 0xbb, 0xeb, 0x62, 0xb1, 0x39, 0x02, 0xbe, 0x17, 0xce,
+	0xc2, 0xa0, 0xbf, 0x95, 0x6b, 0x6d, 0xc3, 0x09, 0x0d, 0x49, 0x51, 0x85, 0x7d, 0x61, 0x03, 0x67,
+	0x6f, 0x96, 0xca, 0x8a, 0xa1, 0x31, 0x59, 0x71, 0x58, 0xd7, 0x68, 0x7c, 0x40, 0x40, 0x4c, 0x33,
+	0x83, 0x9c, 0x14, 0xd8, 0xca, 0x70, 0xbd, 0x68, 0x29, 0x0e, 0x43, 0x33, 0xa7, 0x5d, 0xd0, 0xbf,
+	0x3d, 0x1a, 0x3d, 0xa0, 0xe9, 0x4e, 0x79, 0x79, 0xb1, 0x50, 0x32, 0xab, 0x98, 0xa7, 0xf8, 0x67,
+	0xd2, 0x56, 0xaf, 0x49, 0xce, 0xad, 0x1a, 0xb3, 0x0b, 0x73, 0xac, 0xf4, 0x60, 0x65, 0x12, 0x10,
+	0xd4, 0x1c, 0x2b, 0xc9, 0xff, 0x63, 0x01, 0x53, 0x62, 0x05, 0x0e, 0x86, 0x81, 0x2c, 0xb0, 0xca,
+	0xd2, 0x1c, 0xab, 0x30, 0x8d, 0x87, 0x72, 0x81, 0xa7, 0x71, 0xd7, 0x78, 0xaf, 0xf5, 0xc2, 0x44,
+	0x16, 0x73, 0xc8, 0xff, 0x1d, 0xa0, 0x36, 0xab, 0x2c, 0x15, 0x17, 0x4d, 0x43, 0x65, 0x6a, 0xd1,
+	0x31, 0xaf, 0x31, 0xc3, 0xee, 0xc0, 0x09, 0xf3, 0x86, 0x13, 0x70, 0xc2, 0xbc, 0xe1, 0xc8, 0xdb,
+	0x5d, 0xbd, 0xb3, 0x5c, 0xed, 0xeb, 0x5c, 0x2b, 0xad, 0x40, 0xae, 0xaa, 0x1b, 0x45, 0x6e, 0x4f,
+	0xf5, 0x01, 0x8d, 0xf4, 0x76, 0xc1, 0xd8, 0x8e, 0xaa, 0x6e, 0x84, 0x79, 0xd2, 0xab, 0x30, 0x68,
+	0x97, 0x4d, 0xcb, 0x59, 0x52, 0x2a, 0x95, 0x91, 0xbe, 0x2e, 0xd8, 0x68, 0xa8, 0x73, 0x7b, 0xc5,
+	0x58, 0xd8, 0xc9, 0x0d, 0xc3, 0x5d, 0x0b, 0x65, 0xd7, 0x3a, 0xdb, 0x13, 0x02, 0xfb, 0x52, 0xd0,
+	0x62, 0x26, 0xbc, 0x0b, 0x43, 0x8d, 0xa0, 0x14, 0x2d, 0x5c, 0xae, 0x77, 0xbb, 0x89, 0xa4, 0x42,
+	0x6f, 0xa8, 0xaa, 0x6b, 0x9a, 0xdd, 0xe3, 0xba, 0xf9, 0x8b, 0xdf, 0x47, 0x73, 0xd1, 0x67, 0xb6,
+	0x9c, 0x53, 0xa3, 0x8b, 0xdd, 0x6b, 0x8b, 0x2b, 0xa4, 0xb9, 0xd8, 0xde, 0x30, 0xdc, 0xd4, 0xd6,
+	0x0d, 0x6d, 0x33, 0x47, 0xe8, 0x21, 0x81, 0x89, 0x2c, 0xb0, 0x31, 0x54, 0x8b, 0x90, 0x5b, 0xae,
+	0x3f, 0x8f, 0x44, 0xea, 0x50, 0x52, 0xa4, 0x62, 0x54, 0x62, 0x73, 0xa6, 0xbe, 0xb6, 0x0d, 0x08,
+	0xc9, 0x67, 0x04, 0x5f, 0x28, 0xc1, 0x6c, 0xf0, 0xfd, 0x8f, 0xd9, 0x90, 0xd9, 0xff, 0xfe, 0x7e,
+	0xee, 0xff, 0x68, 0x00, 0x7b, 0xdb, 0x0a, 0xe0, 0xa9, 0xff, 0x7e, 0x78, 0x77, 0xb4, 0xe7, 0xc9,
+	0xdd, 0xd1, 0x1e, 0xf1, 0x06, 0xec, 0x8a, 0xa0, 0x44, 0x77, 0xbf, 0x0d, 0xb9, 0x98, 0xca, 0xc0,
+	0x37, 0x60, 0x1b, 0x85, 0x21, 0xd3, 0x68, 0xee, 0x8b, 0x5f, 0x13, 0x18, 0xe5, 0x86, 0x63, 0xc2,
+	0xb3, 0x19, 0xfd, 0x54, 0x85, 0xb1, 0x64, 0xb8, 0xe8, 0xb0, 0x79, 0x18, 0xf0, 0x32, 0x0a, 0x7d,
+	0xd4, 0x41, 0x4a, 0xa2, 0x02, 0xf1, 0xdb, 0x7a, 0xa7, 0x9d, 0xab, 0x13, 0x8a, 0xaf, 0xe3, 0xf5,
+	0xf9, 0xa7, 0x4b, 0x75, 0x1c, 0x70, 0xd3, 0x4f, 0xf5, 0x9e, 0x1b, 0x8f, 0x1b, 0x1d, 0x55, 0xea,
+	0x5a, 0xcf, 0xf5, 0xbc, 0xb6, 0xb1, 0xcd, 0xf5, 0x5e, 0xbd, 0xb9, 0xfa, 0x9c, 0x5a, 0x34, 0xd7,
+	0xcd, 0x16, 0x14, 0xbf, 0xcd, 0xb6, 0x20, 0xf0, 0x34, 0xb6, 0xd9, 0x7b, 0xbd, 0xb0, 0x9b, 0x73,
+	0x93, 0x99, 0xba, 0x21, 0xc1, 0xa0, 0xb6, 0x55, 0x2a, 0xb6, 0xd9, 0x45, 0xb6, 0xdb, 0x56, 0xe9,
+	0x72, 0xd3, 0x1b, 0x93, 0xaa, 0xb6, 0xd3, 0xac, 0xa7, 0xaf, 0x95, 0x1e, 0xd5, 0x76, 0x2e, 0xa7,
+	0xbc, 0x79, 0xfb, 0xbb, 0x90, 0x1c, 0xab, 0x04, 0x84, 0x38, 0x07, 0x62, 0x32, 0xe8, 0x30, 0x6c,
+	0xb1, 0x94, 0x62, 0x3d, 0x9c, 0x94, 0x0f, 0x41, 0x75, 0x4d, 0xe5, 0xba, 0xd3, 0x62, 0x1b, 0x7d,
+	0x1a, 0x1a, 0x0d, 0xe7, 0x7b, 0x74, 0xac, 0xde, 0x84, 0x65, 0xba, 0x12, 0xe9, 0xf9, 0x4f, 0xc5,
+	0x48, 0xfe, 0x15, 0x81, 0x7c, 0x02, 0xec, 0xcd, 0xf8, 0x22, 0x2f, 0x27, 0xe6, 0x46, 0xb7, 0x07,
+	0xfe, 0x63, 0x58, 0x58, 0x2f, 0xeb, 0xb6, 0x63, 0x5a, 0x7a, 0x49, 0xa9, 0xcc, 0x1b, 0x4b, 0x66,
+	0xe0, 0x5e, 0xa7, 0xcc, 0x74, 0xad, 0xec, 0x70, 0x0b, 0x7d, 0x32, 0x7e, 0x13, 0xdf, 0x82, 0x3d,
+	0xb1, 0x52, 0x88, 0xed, 0x14, 0xf4, 0x97, 0x75, 0xdb, 0x19, 0x21, 0xe1, 0x84, 0x6b, 0x86, 0xd5,
+	0x24, 0xcd, 0x65, 0x44, 0x0a, 0xdb, 0xb9, 0xea, 0x8b, 0xa6, 0x59, 0x41, 0x18, 0xe2, 0x05, 0xd8,
+	0x11, 0x58, 0x43, 0x23, 0xc7, 0xa1, 0xbf, 0x66, 0x9a, 0x15, 0x34, 0xb2, 0x37, 0xc9, 0x88, 0x2b,
+	0x83, 0xb4, 0xf9, 0x7e, 0x71, 0x08, 0xa8, 0xa7, 0x4c, 0xb1, 0x94, 0x6a, 0xbd, 0xd4, 0xc4, 0x05,
+	0xc8, 0x85, 0x56, 0xd1, 0xc8, 0x69, 0x18, 0xa8, 0xf1, 0x15, 0x34, 0x93, 0x4f, 0x34, 0xc3, 0x77,
+	0xd5, 0x0f, 0x48, 0x9e, 0xcc, 0xd4, 0xf7, 0xbb, 0xe1, 0x3f, 0x5c, 0x2b, 0xfd, 0x94, 0x00, 0x34,
+	0x0a, 0x85, 0x16, 0x92, 0xd4, 0xc4, 0xdf, 0xaf, 0x09, 0x52, 0xe6, 0xfd, 0x78, 0x72, 0x9d, 0x78,
+	0xff, 0xe7, 0x3f, 0x3f, 0xe9, 0xdd, 0x4f, 0x45, 0x29, 0xe1, 0xd2, 0x2f, 0x50, 0x64, 0x9f, 0x13,
+	0x18, 0xf4, 0x55, 0xd0, 0xc9, 0x6c, 0xa6, 0xea, 0xc8, 0x0a, 0x59, 0xb7, 0x23, 0xb0, 0xe7, 0x39,
+	0xb0, 0xe7, 0xe8, 0xd1, 0xd6, 0xc0, 0xa4, 0xdb, 0xe1, 0x72, 0xba, 0x43, 0x1f, 0x12, 0xd8, 0x19,
+	0x7b, 0x65, 0x44, 0x4f, 0x66, 0x83, 0x11, 0x73, 0x9b, 0x25, 0x9c, 0xea, 0x44, 0x14, 0xd9, 0xcc,
+	0x73, 0x36, 0x2f, 0xd1, 0x99, 0x0e, 0xd8, 0x48, 0xa1, 0xbb, 0x2d, 0xfa, 0x0f, 0x81, 0xff, 0xa7,
+	0x5e, 0x0b, 0xd1, 0x99, 0x6c, 0x40, 0x53, 0x6e, 0xb0, 0x84, 0xd9, 0xf5, 0xa8, 0x40, 0xce, 0x32,
+	0xe7, 0xfc, 0x2a, 0x7d, 0xa5, 0x13, 0xce, 0x4d, 0xf7, 0x4b, 0x45, 0xbc, 0x27, 0xfe, 0x85, 0xc0,
+	0x50, 0xdc, 0x05, 0x08, 0x9d, 0xce, 0x06, 0x38, 0x7a, 0xc4, 0x15, 0x4e, 0x76, 0x20, 0x89, 0x0c,
+	0xcf, 0x73, 0x86, 0x33, 0xf4, 0x4c, 0x27, 0x0c, 0xd5, 0x00, 0xfa, 0xbf, 0x83, 0x31, 0x8d, 0x3b,
+	0xce, 0x66, 0x8d, 0x69, 0xca, 0x59, 0x5e, 0x98, 0x5d, 0x8f, 0x0a, 0x64, 0x7c, 0x89, 0x33, 0xbe,
+	0x40, 0xe7, 0x3b, 0x61, 0xdc, 0x38, 0x87, 0x07, 0xb9, 0xff, 0x40, 0x00, 0x1a, 0xa6, 0x5a, 0x74,
+	0xbc, 0xc8, 0x58, 0x2d, 0x48, 0x99, 0xf7, 0x23, 0x85, 0x2b, 0x9c, 0x82, 0x4c, 0x2f, 0xae, 0x33,
+	0x68, 0xd2, 0xed, 0xf0, 0x29, 0xe0, 0x0e, 0xfd, 0x8b, 0x40, 0x2e, 0xc6, 0x7b, 0xf4, 0x44, 0x2a,
+	0xc4, 0xe4, 0x2b, 0x03, 0x61, 0xba, 0x7d, 0x41, 0x24, 0x59, 0xe5, 0x24, 0x35, 0xca, 0xba, 0x4d,
+	0x32, 0x36, 0x88, 0xf4, 0x47, 0x02, 0x43, 0x71, 0x33, 0x72, 0x8b, 0xb2, 0x4c, 0xb9, 0x0e, 0x68,
+	0x51, 0x96, 0x69, 0x03, 0xb9, 0x78, 0x9a, 0x93, 0x3f, 0x4e, 0x8f, 0x25, 0x91, 0x4f, 0x8d, 0xa2,
+	0x5b, 0x8b, 0xa9, 0xa3, 0x65, 0x8b, 0x5a, 0xcc, 0x32, 0x57, 0xb7, 0xa8, 0xc5, 0x4c, 0x93, 0x6d,
+	0xeb, 0x5a, 0xf4, 0x99, 0x65, 0x0c, 0xa3, 0x4d, 0xbf, 0x23, 0xb0, 0x35, 0x34, 0x39, 0xd1, 0x23,
+	0xa9, 0x40, 0xe3, 0xc6, 0x54, 0x61, 0xaa, 0x1d, 0x91, 0xac, 0xef, 0xc7, 0x34, 0x2e, 0x56, 0x08,
+	0xf1, 0x2a, 0x81, 0x5c, 0xcc, 0xcc, 0xd1, 0xa2, 0x0a, 0x93, 0x87, 0x2b, 0x61, 0xba, 0x7d, 0x41,
+	0x64, 0x75, 0x8e, 0xb3, 0x7a, 0x91, 0xbe, 0xd0, 0x09, 0xab, 0xc0, 0xc1, 0xeb, 0x11, 0x01, 0x1a,
+	0xb5, 0x43, 0x8f, 0xb7, 0x09, 0xac, 0x4e, 0xe8, 0x44, 0xdb, 0x72, 0xc8, 0xe7, 0x4d, 0xce, 0xe7,
+	0x12, 0x7d, 0x6d, 0x7d, 0x7c, 0xa2, 0xe7, 0xb5, 0x6f, 0x08, 0x6c, 0x0b, 0x1f, 0xf2, 0x69, 0x7a,
+	0x16, 0xc5, 0x4e, 0x21, 0xc2, 0xd1, 0xb6, 0x64, 0x90, 0xd4, 0x34, 0x27, 0x35, 0x45, 0x9f, 0x4d,
+	0x22, 0x55, 0xf6, 0xe5, 0x8a, 0xba, 0xb1, 0x64, 0x4a, 0xb7, 0xbd, 0xd9, 0xe6, 0x0e, 0x7d, 0x8f,
+	0x40, 0xbf, 0x3b, 0x35, 0xd0, 0xf1, 0x54, 0xbb, 0x81, 0x01, 0x45, 0x38, 0x98, 0x61, 0x27, 0xe2,
+	0xda, 0xcf, 0x71, 0xe5, 0xe9, 0xde, 0x24, 0x5c, 0xee, 0x90, 0x42, 0x3f, 0x22, 0x30, 0xe0, 0x8d,
+	0x14, 0x74, 0x22, 0x5d, 0x77, 0x70, 0x8a, 0x11, 0x0e, 0x65, 0xda, 0x8b, 0x48, 0x0e, 0x70, 0x24,
+	0x63, 0x34, 0x9f, 0x88, 0xc4, 0x9b, 0x69, 0xce, 0xdd, 0x5f, 0xcb, 0x93, 0xd5, 0xb5, 0x3c, 0xf9,
+	0x63, 0x2d, 0x4f, 0x3e, 0x7e, 0x9c, 0xef, 0x59, 0x7d, 0x9c, 0xef, 0xf9, 0xf5, 0x71, 0xbe, 0xe7,
+	0xea, 0xe1, 0xd4, 0xdf, 0xea, 0x6e, 0xfa, 0x0a, 0xf9, 0xaf, 0x76, 0x8b, 0x03, 0xfc, 0xbf, 0x08,
+	0x8e, 0xfe, 0x3b, 0x00, 0xf2, 0xec, 0xbf, 0x16, 0x24, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1529,6 +1738,12 @@ type QueryClient interface {
 	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
 	// Validator queries validator info for given validator address.
 	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
+	// ValidatorExchangeRate queries the amount of tokens each delegator share of
+	// a validator is worth.
+	ValidatorExchangeRate(ctx context.Context, in *QueryValidatorExchangeRateRequest, opts ...grpc.CallOption) (*QueryValidatorExchangeRateResponse, error)
+	// ValidatorSelfDelegationStatus queries the self-delegation of a validator
+	// against its minimum self delegation.
+	ValidatorSelfDelegationStatus(ctx context.Context, in *QueryValidatorSelfDelegationStatusRequest, opts ...grpc.CallOption) (*QueryValidatorSelfDelegationStatusResponse, error)
 	// ValidatorDelegations queries delegate info for given validator.
 	ValidatorDelegations(ctx context.Context, in *QueryValidatorDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationsResponse, error)
 	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
@@ -1585,6 +1800,24 @@ func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest,
 	return out, nil
 }
 
+func (c *queryClient) ValidatorExchangeRate(ctx context.Context, in *QueryValidatorExchangeRateRequest, opts ...grpc.CallOption) (*QueryValidatorExchangeRateResponse, error) {
+	out := new(QueryValidatorExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSelfDelegationStatus(ctx context.Context, in *QueryValidatorSelfDelegationStatusRequest, opts ...grpc.CallOption) (*QueryValidatorSelfDelegationStatusResponse, error) {
+	out := new(QueryValidatorSelfDelegationStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorSelfDelegationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorDelegations(ctx context.Context, in *QueryValidatorDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationsResponse, error) {
 	out := new(QueryValidatorDelegationsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorDelegations", in, out, opts...)
@@ -1699,6 +1932,12 @@ type QueryServer interface {
 	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
 	// Validator queries validator info for given validator address.
 	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
+	// ValidatorExchangeRate queries the amount of tokens each delegator share of
+	// a validator is worth.
+	ValidatorExchangeRate(context.Context, *QueryValidatorExchangeRateRequest) (*QueryValidatorExchangeRateResponse, error)
+	// ValidatorSelfDelegationStatus queries the self-delegation of a validator
+	// against its minimum self delegation.
+	ValidatorSelfDelegationStatus(context.Context, *QueryValidatorSelfDelegationStatusRequest) (*QueryValidatorSelfDelegationStatusResponse, error)
 	// ValidatorDelegations queries delegate info for given validator.
 	ValidatorDelegations(context.Context, *QueryValidatorDelegationsRequest) (*QueryValidatorDelegationsResponse, error)
 	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
@@ -1739,6 +1978,12 @@ func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryValid
 func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorExchangeRate(ctx context.Context, req *QueryValidatorExchangeRateRequest) (*QueryValidatorExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSelfDelegationStatus(ctx context.Context, req *QueryValidatorSelfDelegationStatusRequest) (*QueryValidatorSelfDelegationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSelfDelegationStatus not implemented")
+}
 func (*UnimplementedQueryServer) ValidatorDelegations(ctx context.Context, req *QueryValidatorDelegationsRequest) (*QueryValidatorDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelegations not implemented")
 }
@@ -1816,6 +2061,42 @@ func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorExchangeRate(ctx, req.(*QueryValidatorExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSelfDelegationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSelfDelegationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSelfDelegationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorSelfDelegationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSelfDelegationStatus(ctx, req.(*QueryValidatorSelfDelegationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorDelegationsRequest)
 	if err := dec(in); err != nil {
@@ -2044,6 +2325,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Validator",
 			Handler:    _Query_Validator_Handler,
 		},
+		{
+			MethodName: "ValidatorExchangeRate",
+			Handler:    _Query_ValidatorExchangeRate_Handler,
+		},
+		{
+			MethodName: "ValidatorSelfDelegationStatus",
+			Handler:    _Query_ValidatorSelfDelegationStatus_Handler,
+		},
 		{
 			MethodName: "ValidatorDelegations",
 			Handler:    _Query_ValidatorDelegations_Handler,
@@ -2251,7 +2540,7 @@ func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorExchangeRateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2261,28 +2550,16 @@ func (m *QueryValidatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.ValidatorAddr) > 0 {
 		i -= len(m.ValidatorAddr)
 		copy(dAtA[i:], m.ValidatorAddr)
@@ -2293,7 +2570,7 @@ func (m *QueryValidatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorExchangeRateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2303,46 +2580,30 @@ func (m *QueryValidatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegationResponses) > 0 {
-		for iNdEx := len(m.DelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorSelfDelegationStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2352,23 +2613,197 @@ func (m *QueryValidatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorSelfDelegationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorSelfDelegationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSelfDelegationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSelfDelegationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSelfDelegationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shortfall.Size()
+		i -= size
+		if _, err := m.Shortfall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SelfBondedTokens.Size()
+		i -= size
+		if _, err := m.SelfBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegationResponses) > 0 {
+		for iNdEx := len(m.DelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
@@ -3269,6 +3704,58 @@ func (m *QueryValidatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorSelfDelegationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSelfDelegationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SelfBondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Shortfall.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryValidatorDelegationsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4053,6 +4540,406 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSelfDelegationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSelfDelegationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSelfDelegationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSelfDelegationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSelfDelegationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSelfDelegationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelfBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryValidatorDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -334,6 +334,9 @@ type Validator struct {
 	Commission Commission `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission"`
 	// min_self_delegation is the validator's self declared minimum self delegation.
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// max_total_delegation is the validator's self declared maximum of delegated
+	// tokens (incl. self-delegation). Zero means there is no maximum.
+	MaxTotalDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=max_total_delegation,json=maxTotalDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_delegation"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -846,6 +849,15 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// pub_key_types is the list of validator consensus public key types accepted
+	// by MsgCreateValidator when the consensus params do not define them.
+	PubKeyTypes []string `protobuf:"bytes,7,rep,name=pub_key_types,json=pubKeyTypes,proto3" json:"pub_key_types,omitempty" yaml:"pub_key_types"`
+	// min_delegation is the minimum amount of bond denom tokens accepted by
+	// MsgDelegate and by a non-zero MsgCreateValidator self-delegation.
+	MinDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=min_delegation,json=minDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation" yaml:"min_delegation"`
+	// allow_zero_self_delegation allows MsgCreateValidator with a zero
+	// min_self_delegation, and then also with a zero initial self-delegation.
+	AllowZeroSelfDelegation bool `protobuf:"varint,9,opt,name=allow_zero_self_delegation,json=allowZeroSelfDelegation,proto3" json:"allow_zero_self_delegation,omitempty" yaml:"allow_zero_self_delegation"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -915,6 +927,20 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetPubKeyTypes() []string {
+	if m != nil {
+		return m.PubKeyTypes
+	}
+	return nil
+}
+
+func (m *Params) GetAllowZeroSelfDelegation() bool {
+	if m != nil {
+		return m.AllowZeroSelfDelegation
+	}
+	return false
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1140,111 +1166,118 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1653 bytes of a gzipped FileDescriptorProto
+	// 1773 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x45, 0x3e, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
-	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x53, 0xab, 0x4e, 0x5d,
-	0x96, 0x94, 0x55, 0xf4, 0x07, 0x5d, 0x0c, 0x77, 0x47, 0xe4, 0x54, 0xbb, 0xb3, 0xc4, 0xce, 0xd0,
-	0x15, 0x81, 0x1e, 0x0a, 0xf4, 0x92, 0xfa, 0x94, 0x63, 0x2e, 0x02, 0x04, 0xa4, 0xc7, 0x1c, 0x83,
-	0x5e, 0x7a, 0xe8, 0x35, 0xcd, 0xc9, 0xc8, 0xa9, 0xe9, 0x8f, 0x5a, 0xd8, 0x97, 0x1e, 0x8b, 0xdc,
-	0x5b, 0x14, 0x33, 0x3b, 0xfb, 0x23, 0x52, 0x54, 0xa4, 0x80, 0x01, 0x02, 0xe4, 0x62, 0xef, 0xcc,
-	0xbc, 0xf7, 0xcd, 0x7b, 0xdf, 0xfb, 0xd1, 0x1b, 0xc2, 0x8b, 0x96, 0xc7, 0x5d, 0x8f, 0x6f, 0x71,
-	0x81, 0x8f, 0x28, 0x1b, 0x6c, 0x3d, 0xba, 0xd3, 0x27, 0x02, 0xdf, 0x09, 0xd7, 0x8d, 0x91, 0xef,
-	0x09, 0x0f, 0xbd, 0x10, 0x48, 0x35, 0xc2, 0x5d, 0x2d, 0x55, 0xde, 0x1c, 0x78, 0x03, 0x4f, 0x89,
-	0x6c, 0xc9, 0xaf, 0x40, 0xba, 0x7c, 0x73, 0xe0, 0x79, 0x03, 0x87, 0x6c, 0xa9, 0x55, 0x7f, 0x7c,
-	0xb8, 0x85, 0xd9, 0x44, 0x1f, 0x55, 0xa6, 0x8f, 0xec, 0xb1, 0x8f, 0x05, 0xf5, 0x98, 0x3e, 0xaf,
-	0x4e, 0x9f, 0x0b, 0xea, 0x12, 0x2e, 0xb0, 0x3b, 0x0a, 0xb1, 0x03, 0x4b, 0xcc, 0xe0, 0x52, 0x6d,
-	0x96, 0xc6, 0xd6, 0xae, 0xf4, 0x31, 0x27, 0x91, 0x1f, 0x96, 0x47, 0x43, 0xec, 0x2f, 0x0b, 0xc2,
-	0x6c, 0xe2, 0xbb, 0x94, 0x89, 0x2d, 0x31, 0x19, 0x11, 0x1e, 0xfc, 0x1b, 0x9c, 0xd6, 0x7f, 0x67,
-	0xc0, 0xda, 0x3d, 0xca, 0x85, 0xe7, 0x53, 0x0b, 0x3b, 0x7b, 0xec, 0xd0, 0x43, 0xaf, 0x41, 0x76,
-	0x48, 0xb0, 0x4d, 0xfc, 0x92, 0x51, 0x33, 0x6e, 0x15, 0xb6, 0x4b, 0x8d, 0x18, 0xa1, 0x11, 0xe8,
-	0xde, 0x53, 0xe7, 0xcd, 0xcc, 0xfb, 0x67, 0xd5, 0x54, 0x57, 0x4b, 0xa3, 0xef, 0x42, 0xf6, 0x11,
-	0x76, 0x38, 0x11, 0xa5, 0x74, 0x6d, 0xe9, 0x56, 0x61, 0xfb, 0xab, 0x8d, 0x8b, 0xe9, 0x6b, 0x1c,
-	0x60, 0x87, 0xda, 0x58, 0x78, 0x11, 0x40, 0xa0, 0x56, 0x7f, 0x37, 0x0d, 0xc5, 0x5d, 0xcf, 0x75,
-	0x29, 0xe7, 0xd4, 0x63, 0x5d, 0x2c, 0x08, 0x47, 0x1d, 0xc8, 0xf8, 0x58, 0x10, 0x65, 0x4a, 0xbe,
-	0xf9, 0x1d, 0x29, 0xff, 0xd7, 0xb3, 0xea, 0x4b, 0x03, 0x2a, 0x86, 0xe3, 0x7e, 0xc3, 0xf2, 0x5c,
-	0x4d, 0x86, 0xfe, 0xef, 0x36, 0xb7, 0x8f, 0xb4, 0x7f, 0x2d, 0x62, 0x7d, 0xf8, 0xde, 0x6d, 0xd0,
-	0x36, 0xb4, 0x88, 0xd5, 0x55, 0x48, 0xe8, 0xc7, 0x90, 0x73, 0xf1, 0xb1, 0xa9, 0x50, 0xd3, 0x0b,
-	0x40, 0x5d, 0x76, 0xf1, 0xb1, 0xb4, 0x15, 0xd9, 0x50, 0x94, 0xc0, 0xd6, 0x10, 0xb3, 0x01, 0x09,
-	0xf0, 0x97, 0x16, 0x80, 0xbf, 0xea, 0xe2, 0xe3, 0x5d, 0x85, 0x29, 0x6f, 0xd9, 0xc9, 0xbd, 0x7d,
-	0x5a, 0x4d, 0xfd, 0xfb, 0xb4, 0x6a, 0xd4, 0xff, 0x68, 0x00, 0xc4, 0x74, 0xa1, 0x9f, 0xc3, 0xba,
-	0x15, 0xad, 0xd4, 0xf5, 0x5c, 0x07, 0xf0, 0xe5, 0x79, 0x81, 0x98, 0x22, 0xbb, 0x99, 0x93, 0x86,
-	0x3e, 0x39, 0xab, 0x1a, 0xdd, 0xa2, 0x35, 0x15, 0x87, 0x36, 0x14, 0xc6, 0x23, 0x1b, 0x0b, 0x62,
-	0xca, 0xd4, 0x54, 0xc4, 0x15, 0xb6, 0xcb, 0x8d, 0x20, 0x6f, 0x1b, 0x61, 0xde, 0x36, 0xf6, 0xc3,
-	0xbc, 0x0d, 0xb0, 0xde, 0xfa, 0x67, 0xd5, 0xe8, 0x42, 0xa0, 0x28, 0x8f, 0x12, 0xd6, 0xbf, 0x6b,
-	0x40, 0xa1, 0x45, 0xb8, 0xe5, 0xd3, 0x91, 0x2c, 0x04, 0x54, 0x82, 0x65, 0xd7, 0x63, 0xf4, 0x48,
-	0xa7, 0x5d, 0xbe, 0x1b, 0x2e, 0x51, 0x19, 0x72, 0xd4, 0x26, 0x4c, 0x50, 0x31, 0x09, 0x02, 0xd6,
-	0x8d, 0xd6, 0x52, 0xeb, 0x57, 0xa4, 0xcf, 0x69, 0xc8, 0x75, 0x37, 0x5c, 0xa2, 0x57, 0x60, 0x9d,
-	0x13, 0x6b, 0xec, 0x53, 0x31, 0x31, 0x2d, 0x8f, 0x09, 0x6c, 0x89, 0x52, 0x46, 0x89, 0x14, 0xc3,
-	0xfd, 0xdd, 0x60, 0x5b, 0x82, 0xd8, 0x44, 0x60, 0xea, 0xf0, 0xd2, 0x73, 0x01, 0x88, 0x5e, 0x26,
-	0xcc, 0xfd, 0x73, 0x16, 0xf2, 0x51, 0xde, 0xa2, 0x5d, 0x58, 0xf7, 0x46, 0xc4, 0x97, 0xdf, 0x26,
-	0xb6, 0x6d, 0x9f, 0x70, 0xae, 0x33, 0xb4, 0xf4, 0xe1, 0x7b, 0xb7, 0x37, 0x35, 0xdd, 0x77, 0x83,
-	0x93, 0x9e, 0xf0, 0x29, 0x1b, 0x74, 0x8b, 0xa1, 0x86, 0xde, 0x46, 0x3f, 0x91, 0x01, 0x63, 0x9c,
-	0x30, 0x3e, 0xe6, 0xe6, 0x68, 0xdc, 0x3f, 0x22, 0x13, 0xcd, 0xeb, 0xe6, 0x0c, 0xaf, 0x77, 0xd9,
-	0xa4, 0x59, 0xfa, 0x20, 0x86, 0xb6, 0xfc, 0xc9, 0x48, 0x78, 0x8d, 0xce, 0xb8, 0x7f, 0x9f, 0x4c,
-	0x64, 0xb4, 0x34, 0x4e, 0x47, 0xc1, 0xa0, 0x17, 0x20, 0xfb, 0x4b, 0x4c, 0x1d, 0x62, 0x2b, 0x56,
-	0x72, 0x5d, 0xbd, 0x42, 0x3b, 0x90, 0xe5, 0x02, 0x8b, 0x31, 0x57, 0x54, 0xac, 0x6d, 0xd7, 0xe7,
-	0x65, 0x46, 0xd3, 0x63, 0x76, 0x4f, 0x49, 0x76, 0xb5, 0x06, 0xda, 0x87, 0xac, 0xf0, 0x8e, 0x08,
-	0xd3, 0x24, 0x5d, 0x2b, 0xab, 0xf7, 0x98, 0x48, 0x64, 0xf5, 0x1e, 0x13, 0x5d, 0x8d, 0x85, 0x06,
-	0xb0, 0x6e, 0x13, 0x87, 0x0c, 0x14, 0x95, 0x7c, 0x88, 0x7d, 0xc2, 0x4b, 0xd9, 0x05, 0x54, 0x4d,
-	0x31, 0x42, 0xed, 0x29, 0x50, 0x74, 0x1f, 0x0a, 0x76, 0x9c, 0x6e, 0xa5, 0x65, 0x45, 0xf4, 0xd7,
-	0xe6, 0xf9, 0x9f, 0xc8, 0x4c, 0xdd, 0xa4, 0x92, 0xda, 0x32, 0xb9, 0xc6, 0xac, 0xef, 0x31, 0x9b,
-	0xb2, 0x81, 0x39, 0x24, 0x74, 0x30, 0x14, 0xa5, 0x5c, 0xcd, 0xb8, 0xb5, 0xd4, 0x2d, 0x46, 0xfb,
-	0xf7, 0xd4, 0x36, 0xba, 0x0f, 0x6b, 0xb1, 0xa8, 0xaa, 0x9d, 0xfc, 0x35, 0x6a, 0x67, 0x35, 0xd2,
-	0x95, 0xa7, 0xe8, 0x1e, 0x40, 0x5c, 0x98, 0x25, 0x50, 0x40, 0xf5, 0x4f, 0xae, 0x6e, 0xed, 0x42,
-	0x42, 0x17, 0x39, 0x70, 0xc3, 0xa5, 0xcc, 0xe4, 0xc4, 0x39, 0x34, 0x35, 0x55, 0x12, 0xb2, 0xb0,
-	0x80, 0xd0, 0x6e, 0xb8, 0x94, 0xf5, 0x88, 0x73, 0xd8, 0x8a, 0x60, 0x77, 0x56, 0xde, 0x3c, 0xad,
-	0xa6, 0x74, 0x2d, 0xa5, 0xea, 0x1d, 0x58, 0x39, 0xc0, 0x8e, 0x2e, 0x03, 0xc2, 0xd1, 0x6b, 0x90,
-	0xc7, 0xe1, 0xa2, 0x64, 0xd4, 0x96, 0x2e, 0x2d, 0xa3, 0x58, 0x34, 0xa8, 0xce, 0xdf, 0xfc, 0xbd,
-	0x66, 0xd4, 0x7f, 0x6f, 0x40, 0xb6, 0x75, 0xd0, 0xc1, 0xd4, 0x47, 0x6d, 0xd8, 0x88, 0x13, 0xea,
-	0xaa, 0xb5, 0x19, 0xe7, 0x60, 0x58, 0x9c, 0x6d, 0xd8, 0x78, 0x14, 0x96, 0x7b, 0x04, 0x93, 0xfe,
-	0x24, 0x98, 0x48, 0x45, 0xef, 0x4f, 0x39, 0xde, 0x86, 0xe5, 0xc0, 0x4a, 0x8e, 0x76, 0xe0, 0xb9,
-	0x91, 0xfc, 0x50, 0xfe, 0x16, 0xb6, 0x2b, 0x73, 0x13, 0x51, 0xc9, 0xeb, 0x00, 0x06, 0x2a, 0xf5,
-	0xff, 0x1a, 0x00, 0xad, 0x83, 0x83, 0x7d, 0x9f, 0x8e, 0x1c, 0x22, 0x16, 0xe5, 0xf1, 0x1b, 0xf0,
-	0x7c, 0xec, 0x31, 0xf7, 0xad, 0x2b, 0x7b, 0x7d, 0x23, 0x52, 0xeb, 0xf9, 0xd6, 0x85, 0x68, 0x36,
-	0x17, 0x11, 0xda, 0xd2, 0x95, 0xd1, 0x5a, 0x5c, 0x5c, 0x4c, 0x63, 0x0f, 0x0a, 0xb1, 0xfb, 0x1c,
-	0xb5, 0x20, 0x27, 0xf4, 0xb7, 0x66, 0xb3, 0x3e, 0x9f, 0xcd, 0x50, 0x4d, 0x33, 0x1a, 0x69, 0xd6,
-	0xff, 0x27, 0x49, 0x8d, 0x32, 0xf6, 0xf3, 0x95, 0x46, 0xb2, 0xf7, 0xea, 0xde, 0xb8, 0x88, 0x89,
-	0x42, 0x63, 0x4d, 0xb1, 0xfa, 0xdb, 0x34, 0xdc, 0x78, 0x18, 0x76, 0x9b, 0xcf, 0x2d, 0x13, 0x1d,
-	0x58, 0x26, 0x4c, 0xf8, 0x54, 0x51, 0x21, 0x63, 0xfd, 0xcd, 0x79, 0xb1, 0xbe, 0xc0, 0x97, 0x36,
-	0x13, 0xfe, 0x44, 0x47, 0x3e, 0x84, 0x99, 0x62, 0xe1, 0x6f, 0x69, 0x28, 0xcd, 0xd3, 0x44, 0x2f,
-	0x43, 0xd1, 0xf2, 0x89, 0xda, 0x08, 0xbb, 0xbe, 0xa1, 0xba, 0xfe, 0x5a, 0xb8, 0xad, 0x9b, 0xfe,
-	0x0f, 0x40, 0x0e, 0x50, 0x32, 0xb1, 0xa4, 0xe8, 0xb5, 0x27, 0xa6, 0xb5, 0x58, 0x59, 0xb5, 0x7d,
-	0x02, 0x45, 0xca, 0xa8, 0xa0, 0xd8, 0x31, 0xfb, 0xd8, 0xc1, 0xcc, 0xfa, 0x34, 0x93, 0xe5, 0x6c,
-	0xa3, 0x5e, 0xd3, 0xa0, 0xcd, 0x00, 0x13, 0x1d, 0xc0, 0x72, 0x08, 0x9f, 0x59, 0x00, 0x7c, 0x08,
-	0x96, 0x98, 0xa2, 0x3e, 0x4a, 0xc3, 0x46, 0x97, 0xd8, 0x5f, 0x2c, 0x5a, 0x7f, 0x06, 0x10, 0x14,
-	0x9c, 0xec, 0x83, 0x9f, 0x82, 0xd9, 0xd9, 0x02, 0xce, 0x07, 0x78, 0x2d, 0x2e, 0x12, 0xdc, 0x7e,
-	0x90, 0x86, 0x95, 0x24, 0xb7, 0x5f, 0x80, 0xbf, 0x0b, 0x68, 0x2f, 0xee, 0x06, 0x19, 0xd5, 0x0d,
-	0x5e, 0x99, 0xd7, 0x0d, 0x66, 0xb2, 0xee, 0xf2, 0x36, 0xf0, 0x71, 0x1a, 0xb2, 0x1d, 0xec, 0x63,
-	0x97, 0xa3, 0xef, 0xcf, 0x0c, 0x70, 0xc1, 0xab, 0xea, 0xe6, 0x4c, 0xce, 0xb5, 0xf4, 0xa3, 0x3e,
-	0x48, 0xb9, 0xb7, 0x2f, 0x98, 0xdf, 0xbe, 0x0e, 0x6b, 0xf2, 0x89, 0x18, 0xb9, 0x12, 0x90, 0xb8,
-	0xaa, 0xde, 0x78, 0xd1, 0xeb, 0x82, 0xa3, 0x2a, 0x14, 0xa4, 0x58, 0xdc, 0xe8, 0xa4, 0x0c, 0xb8,
-	0xf8, 0xb8, 0x1d, 0xec, 0xa0, 0xdb, 0x80, 0x86, 0xd1, 0xa3, 0xdd, 0x8c, 0x29, 0x90, 0x72, 0x1b,
-	0xf1, 0x49, 0x28, 0xfe, 0x15, 0x00, 0x69, 0x85, 0x69, 0x13, 0xe6, 0xb9, 0xfa, 0x8d, 0x93, 0x97,
-	0x3b, 0x2d, 0xb9, 0x81, 0x7e, 0x1d, 0xcc, 0x82, 0x53, 0xaf, 0x47, 0x3d, 0x86, 0xbf, 0x71, 0xbd,
-	0x4c, 0xfd, 0xf8, 0xac, 0x5a, 0x9e, 0x60, 0xd7, 0xd9, 0xa9, 0x5f, 0x00, 0x59, 0x57, 0xb3, 0xe1,
-	0xf9, 0x57, 0x67, 0x22, 0x83, 0xdf, 0x31, 0x00, 0xc5, 0x2d, 0xb7, 0x4b, 0xf8, 0x48, 0x3e, 0x6b,
-	0xe4, 0xd0, 0x9b, 0x98, 0x50, 0x8d, 0xcb, 0x87, 0xde, 0x58, 0x3f, 0x1c, 0x7a, 0x13, 0x15, 0xf1,
-	0xed, 0xb8, 0xc1, 0xa5, 0x75, 0x0c, 0x35, 0x4c, 0x1f, 0x73, 0x92, 0x18, 0x9c, 0x69, 0xa8, 0x3d,
-	0xd3, 0xc3, 0x52, 0xf5, 0x8f, 0x0c, 0xb8, 0x39, 0x93, 0x4d, 0x91, 0xb1, 0xbf, 0x00, 0xe4, 0x27,
-	0x0e, 0x55, 0x6c, 0x26, 0xda, 0xe8, 0x6b, 0x27, 0xe7, 0x86, 0x3f, 0xd3, 0x2b, 0x3f, 0xab, 0x1e,
-	0x9d, 0x51, 0x11, 0xf8, 0x93, 0x01, 0x9b, 0x49, 0x63, 0x22, 0xb7, 0x1e, 0xc0, 0x4a, 0xd2, 0x16,
-	0xed, 0xd0, 0x8b, 0x57, 0x71, 0x48, 0xfb, 0x72, 0x4e, 0x1f, 0xfd, 0x28, 0x2e, 0xdc, 0xe0, 0xc7,
-	0xa2, 0x3b, 0x57, 0xe6, 0x26, 0xb4, 0x69, 0xba, 0x80, 0x33, 0x2a, 0x3a, 0xff, 0x30, 0x20, 0xd3,
-	0xf1, 0x3c, 0x07, 0x0d, 0x61, 0x83, 0x79, 0xc2, 0x94, 0x59, 0x4e, 0x6c, 0x53, 0xbf, 0x5c, 0x8d,
-	0x05, 0x50, 0x56, 0x64, 0x9e, 0x68, 0x2a, 0xd4, 0xfd, 0xe0, 0x09, 0x8b, 0x61, 0xf5, 0xfc, 0x2d,
-	0xe9, 0x05, 0xdc, 0xb2, 0xd2, 0x4f, 0x5c, 0xb1, 0x93, 0x93, 0xd1, 0xf9, 0xcf, 0x69, 0xd5, 0xf8,
-	0xc6, 0x1f, 0x0c, 0x80, 0xf8, 0x71, 0x8e, 0x5e, 0x85, 0x2f, 0x35, 0x7f, 0xf8, 0xa0, 0x65, 0xf6,
-	0xf6, 0xef, 0xee, 0x3f, 0xec, 0x99, 0x0f, 0x1f, 0xf4, 0x3a, 0xed, 0xdd, 0xbd, 0xd7, 0xf7, 0xda,
-	0xad, 0xf5, 0x54, 0xb9, 0xf8, 0xf8, 0xa4, 0x56, 0x78, 0xc8, 0xf8, 0x88, 0x58, 0xf4, 0x90, 0x12,
-	0x1b, 0xbd, 0x04, 0x9b, 0xe7, 0xa5, 0xe5, 0xaa, 0xdd, 0x5a, 0x37, 0xca, 0x2b, 0x8f, 0x4f, 0x6a,
-	0xb9, 0x60, 0xee, 0x21, 0x36, 0xba, 0x05, 0xcf, 0xcf, 0xca, 0xed, 0x3d, 0xf8, 0xde, 0x7a, 0xba,
-	0xbc, 0xfa, 0xf8, 0xa4, 0x96, 0x8f, 0x06, 0x24, 0x54, 0x07, 0x94, 0x94, 0xd4, 0x78, 0x4b, 0x65,
-	0x78, 0x7c, 0x52, 0xcb, 0x06, 0x2c, 0x95, 0x33, 0x6f, 0xbe, 0x53, 0x49, 0x35, 0x5f, 0x7f, 0xff,
-	0x69, 0xc5, 0x78, 0xf2, 0xb4, 0x62, 0xfc, 0xeb, 0x69, 0xc5, 0x78, 0xeb, 0x59, 0x25, 0xf5, 0xe4,
-	0x59, 0x25, 0xf5, 0x97, 0x67, 0x95, 0xd4, 0x4f, 0x5f, 0xbd, 0x94, 0xa0, 0xe3, 0xe8, 0x37, 0x5a,
-	0x45, 0x55, 0x3f, 0xab, 0xda, 0xed, 0xb7, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x4e, 0x6d,
-	0x7d, 0xc2, 0x15, 0x00, 0x00,
+	0x15, 0xe6, 0x52, 0x34, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x96, 0x13, 0x9a, 0x68, 0x49, 0x86, 0xcd,
+	0x8f, 0x53, 0xc4, 0x54, 0xad, 0x02, 0x01, 0x2a, 0x04, 0x28, 0x4c, 0x51, 0x89, 0x55, 0xa7, 0x2e,
+	0xbb, 0x94, 0x55, 0x34, 0x2d, 0xba, 0x18, 0xee, 0x8e, 0xa8, 0xa9, 0x76, 0x67, 0x89, 0x9d, 0xa1,
+	0x23, 0x16, 0x3d, 0x14, 0x68, 0x0f, 0xa9, 0x4f, 0x39, 0xe6, 0x62, 0xc0, 0x40, 0x7a, 0xcc, 0x31,
+	0x28, 0x50, 0xf4, 0xd0, 0x6b, 0x90, 0x93, 0x91, 0x53, 0xd3, 0x1f, 0xb5, 0xb0, 0x2f, 0x3d, 0x16,
+	0xbe, 0xb7, 0x28, 0x66, 0x76, 0xf6, 0x47, 0xa4, 0xa8, 0x48, 0x05, 0x03, 0x04, 0xf0, 0x45, 0xe2,
+	0xcc, 0xbc, 0xf7, 0xcd, 0x7b, 0xdf, 0xbc, 0xf7, 0xf6, 0xcd, 0xc0, 0x8b, 0xb6, 0xcf, 0x3d, 0x9f,
+	0xaf, 0x73, 0x81, 0x0f, 0x29, 0xeb, 0xaf, 0xdf, 0xbb, 0xd1, 0x23, 0x02, 0xdf, 0x88, 0xc6, 0xcd,
+	0x41, 0xe0, 0x0b, 0x1f, 0x3d, 0x17, 0x4a, 0x35, 0xa3, 0x59, 0x2d, 0x55, 0x59, 0xeb, 0xfb, 0x7d,
+	0x5f, 0x89, 0xac, 0xcb, 0x5f, 0xa1, 0x74, 0xe5, 0x6a, 0xdf, 0xf7, 0xfb, 0x2e, 0x59, 0x57, 0xa3,
+	0xde, 0x70, 0x7f, 0x1d, 0xb3, 0x91, 0x5e, 0xaa, 0x8e, 0x2f, 0x39, 0xc3, 0x00, 0x0b, 0xea, 0x33,
+	0xbd, 0x5e, 0x1b, 0x5f, 0x17, 0xd4, 0x23, 0x5c, 0x60, 0x6f, 0x10, 0x61, 0x87, 0x96, 0x58, 0xe1,
+	0xa6, 0xda, 0x2c, 0x8d, 0xad, 0x5d, 0xe9, 0x61, 0x4e, 0x62, 0x3f, 0x6c, 0x9f, 0x46, 0xd8, 0x5f,
+	0x13, 0x84, 0x39, 0x24, 0xf0, 0x28, 0x13, 0xeb, 0x62, 0x34, 0x20, 0x3c, 0xfc, 0x1b, 0xae, 0x36,
+	0x7e, 0x6b, 0xc0, 0xf2, 0x2d, 0xca, 0x85, 0x1f, 0x50, 0x1b, 0xbb, 0x3b, 0x6c, 0xdf, 0x47, 0xaf,
+	0x43, 0xfe, 0x80, 0x60, 0x87, 0x04, 0x65, 0xa3, 0x6e, 0x5c, 0x2b, 0x6e, 0x94, 0x9b, 0x09, 0x42,
+	0x33, 0xd4, 0xbd, 0xa5, 0xd6, 0x5b, 0xb9, 0x4f, 0x8e, 0x6b, 0x19, 0x53, 0x4b, 0xa3, 0xef, 0x42,
+	0xfe, 0x1e, 0x76, 0x39, 0x11, 0xe5, 0x6c, 0x7d, 0xee, 0x5a, 0x71, 0xe3, 0x85, 0xe6, 0xe9, 0xf4,
+	0x35, 0xf7, 0xb0, 0x4b, 0x1d, 0x2c, 0xfc, 0x18, 0x20, 0x54, 0x6b, 0x7c, 0x94, 0x85, 0xd2, 0x96,
+	0xef, 0x79, 0x94, 0x73, 0xea, 0x33, 0x13, 0x0b, 0xc2, 0x51, 0x07, 0x72, 0x01, 0x16, 0x44, 0x99,
+	0xb2, 0xd0, 0x7a, 0x43, 0xca, 0xff, 0xe5, 0xb8, 0xf6, 0x72, 0x9f, 0x8a, 0x83, 0x61, 0xaf, 0x69,
+	0xfb, 0x9e, 0x26, 0x43, 0xff, 0xbb, 0xce, 0x9d, 0x43, 0xed, 0x5f, 0x9b, 0xd8, 0x9f, 0x7d, 0x7c,
+	0x1d, 0xb4, 0x0d, 0x6d, 0x62, 0x9b, 0x0a, 0x09, 0xfd, 0x08, 0x0a, 0x1e, 0x3e, 0xb2, 0x14, 0x6a,
+	0x76, 0x06, 0xa8, 0xf3, 0x1e, 0x3e, 0x92, 0xb6, 0x22, 0x07, 0x4a, 0x12, 0xd8, 0x3e, 0xc0, 0xac,
+	0x4f, 0x42, 0xfc, 0xb9, 0x19, 0xe0, 0x2f, 0x79, 0xf8, 0x68, 0x4b, 0x61, 0xca, 0x5d, 0x36, 0x0b,
+	0x1f, 0x3c, 0xac, 0x65, 0xfe, 0xf5, 0xb0, 0x66, 0x34, 0xfe, 0x68, 0x00, 0x24, 0x74, 0xa1, 0x9f,
+	0xc2, 0x8a, 0x1d, 0x8f, 0xd4, 0xf6, 0x5c, 0x1f, 0xe0, 0x2b, 0xd3, 0x0e, 0x62, 0x8c, 0xec, 0x56,
+	0x41, 0x1a, 0xfa, 0xe8, 0xb8, 0x66, 0x98, 0x25, 0x7b, 0xec, 0x1c, 0xb6, 0xa1, 0x38, 0x1c, 0x38,
+	0x58, 0x10, 0x4b, 0x86, 0xa6, 0x22, 0xae, 0xb8, 0x51, 0x69, 0x86, 0x71, 0xdb, 0x8c, 0xe2, 0xb6,
+	0xb9, 0x1b, 0xc5, 0x6d, 0x88, 0xf5, 0xfe, 0x3f, 0x6a, 0x86, 0x09, 0xa1, 0xa2, 0x5c, 0x4a, 0x59,
+	0xff, 0x91, 0x01, 0xc5, 0x36, 0xe1, 0x76, 0x40, 0x07, 0x32, 0x11, 0x50, 0x19, 0xe6, 0x3d, 0x9f,
+	0xd1, 0x43, 0x1d, 0x76, 0x0b, 0x66, 0x34, 0x44, 0x15, 0x28, 0x50, 0x87, 0x30, 0x41, 0xc5, 0x28,
+	0x3c, 0x30, 0x33, 0x1e, 0x4b, 0xad, 0x77, 0x49, 0x8f, 0xd3, 0x88, 0x6b, 0x33, 0x1a, 0xa2, 0x57,
+	0x61, 0x85, 0x13, 0x7b, 0x18, 0x50, 0x31, 0xb2, 0x6c, 0x9f, 0x09, 0x6c, 0x8b, 0x72, 0x4e, 0x89,
+	0x94, 0xa2, 0xf9, 0xad, 0x70, 0x5a, 0x82, 0x38, 0x44, 0x60, 0xea, 0xf2, 0xf2, 0xa5, 0x10, 0x44,
+	0x0f, 0x53, 0xe6, 0xfe, 0x61, 0x1e, 0x16, 0xe2, 0xb8, 0x45, 0x5b, 0xb0, 0xe2, 0x0f, 0x48, 0x20,
+	0x7f, 0x5b, 0xd8, 0x71, 0x02, 0xc2, 0xb9, 0x8e, 0xd0, 0xf2, 0x67, 0x1f, 0x5f, 0x5f, 0xd3, 0x74,
+	0xdf, 0x0c, 0x57, 0xba, 0x22, 0xa0, 0xac, 0x6f, 0x96, 0x22, 0x0d, 0x3d, 0x8d, 0x7e, 0x2c, 0x0f,
+	0x8c, 0x71, 0xc2, 0xf8, 0x90, 0x5b, 0x83, 0x61, 0xef, 0x90, 0x8c, 0x34, 0xaf, 0x6b, 0x13, 0xbc,
+	0xde, 0x64, 0xa3, 0x56, 0xf9, 0xd3, 0x04, 0xda, 0x0e, 0x46, 0x03, 0xe1, 0x37, 0x3b, 0xc3, 0xde,
+	0x6d, 0x32, 0x32, 0x4b, 0x31, 0x4e, 0x47, 0xc1, 0xa0, 0xe7, 0x20, 0xff, 0x73, 0x4c, 0x5d, 0xe2,
+	0x28, 0x56, 0x0a, 0xa6, 0x1e, 0xa1, 0x4d, 0xc8, 0x73, 0x81, 0xc5, 0x90, 0x2b, 0x2a, 0x96, 0x37,
+	0x1a, 0xd3, 0x22, 0xa3, 0xe5, 0x33, 0xa7, 0xab, 0x24, 0x4d, 0xad, 0x81, 0x76, 0x21, 0x2f, 0xfc,
+	0x43, 0xc2, 0x34, 0x49, 0x17, 0x8a, 0xea, 0x1d, 0x26, 0x52, 0x51, 0xbd, 0xc3, 0x84, 0xa9, 0xb1,
+	0x50, 0x1f, 0x56, 0x1c, 0xe2, 0x92, 0xbe, 0xa2, 0x92, 0x1f, 0xe0, 0x80, 0xf0, 0x72, 0x7e, 0x06,
+	0x59, 0x53, 0x8a, 0x51, 0xbb, 0x0a, 0x14, 0xdd, 0x86, 0xa2, 0x93, 0x84, 0x5b, 0x79, 0x5e, 0x11,
+	0xfd, 0x8d, 0x69, 0xfe, 0xa7, 0x22, 0x53, 0x17, 0xa9, 0xb4, 0xb6, 0x0c, 0xae, 0x21, 0xeb, 0xf9,
+	0xcc, 0xa1, 0xac, 0x6f, 0x1d, 0x10, 0xda, 0x3f, 0x10, 0xe5, 0x42, 0xdd, 0xb8, 0x36, 0x67, 0x96,
+	0xe2, 0xf9, 0x5b, 0x6a, 0x1a, 0xdd, 0x86, 0xe5, 0x44, 0x54, 0xe5, 0xce, 0xc2, 0x05, 0x72, 0x67,
+	0x29, 0xd6, 0x95, 0xab, 0xe8, 0x16, 0x40, 0x92, 0x98, 0x65, 0x50, 0x40, 0x8d, 0x2f, 0xce, 0x6e,
+	0xed, 0x42, 0x4a, 0x17, 0xb9, 0x70, 0xd9, 0xa3, 0xcc, 0xe2, 0xc4, 0xdd, 0xb7, 0x34, 0x55, 0x12,
+	0xb2, 0x38, 0x83, 0xa3, 0x5d, 0xf5, 0x28, 0xeb, 0x12, 0x77, 0xbf, 0x1d, 0xc3, 0x22, 0x06, 0x6b,
+	0xb2, 0x34, 0x0a, 0x5f, 0x60, 0x37, 0xbd, 0xdd, 0xe2, 0x0c, 0xb6, 0x43, 0x1e, 0x3e, 0xda, 0x95,
+	0xc0, 0xc9, 0x7e, 0x9b, 0x8b, 0xef, 0x3d, 0xac, 0x65, 0x74, 0xee, 0x66, 0x1a, 0x1d, 0x58, 0xdc,
+	0xc3, 0xae, 0x4e, 0x3b, 0xc2, 0xd1, 0xeb, 0xb0, 0x80, 0xa3, 0x41, 0xd9, 0xa8, 0xcf, 0x9d, 0x99,
+	0xb6, 0x89, 0x68, 0x58, 0x0d, 0x7e, 0xf5, 0xb7, 0xba, 0xd1, 0xf8, 0x9d, 0x01, 0xf9, 0xf6, 0x5e,
+	0x07, 0xd3, 0x00, 0x6d, 0xc3, 0x6a, 0x12, 0xc0, 0xe7, 0xad, 0x05, 0x49, 0xcc, 0xeb, 0x79, 0x09,
+	0x73, 0x2f, 0x2a, 0x2f, 0x31, 0x4c, 0xf6, 0x8b, 0x60, 0x62, 0x15, 0x3d, 0x3f, 0xe6, 0xf8, 0x36,
+	0xcc, 0x87, 0x56, 0x72, 0xb4, 0x09, 0x97, 0x06, 0xf2, 0x87, 0xf2, 0xb7, 0xb8, 0x51, 0x9d, 0x1a,
+	0xf8, 0x4a, 0x5e, 0x07, 0x4c, 0xa8, 0xd2, 0xf8, 0x8f, 0x01, 0xd0, 0xde, 0xdb, 0xdb, 0x0d, 0xe8,
+	0xc0, 0x25, 0x62, 0x56, 0x1e, 0xbf, 0x0d, 0x57, 0x12, 0x8f, 0x79, 0x60, 0x9f, 0xdb, 0xeb, 0xcb,
+	0xb1, 0x5a, 0x37, 0xb0, 0x4f, 0x45, 0x73, 0xb8, 0x88, 0xd1, 0xe6, 0xce, 0x8d, 0xd6, 0xe6, 0xe2,
+	0x74, 0x1a, 0xbb, 0x50, 0x4c, 0xdc, 0xe7, 0xa8, 0x0d, 0x05, 0xa1, 0x7f, 0x6b, 0x36, 0x1b, 0xd3,
+	0xd9, 0x8c, 0xd4, 0x34, 0xa3, 0xb1, 0x66, 0xe3, 0xbf, 0x92, 0xd4, 0x24, 0x43, 0xbe, 0x52, 0x61,
+	0x24, 0x6b, 0xbd, 0xae, 0xc5, 0xb3, 0xe8, 0x60, 0x34, 0xd6, 0x18, 0xab, 0xbf, 0xce, 0xc2, 0xe5,
+	0xbb, 0x51, 0x75, 0xfb, 0xca, 0x32, 0xd1, 0x81, 0x79, 0xc2, 0x44, 0x40, 0x15, 0x15, 0xf2, 0xac,
+	0xbf, 0x35, 0xed, 0xac, 0x4f, 0xf1, 0x65, 0x9b, 0x89, 0x60, 0xa4, 0x4f, 0x3e, 0x82, 0x19, 0x63,
+	0xe1, 0xaf, 0x59, 0x28, 0x4f, 0xd3, 0x44, 0xaf, 0x40, 0xc9, 0x0e, 0x88, 0x9a, 0x88, 0xbe, 0x32,
+	0x86, 0xfa, 0xca, 0x2c, 0x47, 0xd3, 0xfa, 0x23, 0xf3, 0x7d, 0x90, 0x0d, 0x9b, 0x0c, 0x2c, 0x29,
+	0x7a, 0xe1, 0x0e, 0x6d, 0x39, 0x51, 0x96, 0xcb, 0x88, 0x40, 0x89, 0x32, 0x2a, 0x28, 0x76, 0xad,
+	0x1e, 0x76, 0x31, 0xb3, 0xff, 0x9f, 0x4e, 0x76, 0xb2, 0x52, 0x2f, 0x6b, 0xd0, 0x56, 0x88, 0x89,
+	0xf6, 0x60, 0x3e, 0x82, 0xcf, 0xcd, 0x00, 0x3e, 0x02, 0x4b, 0x75, 0x6d, 0x9f, 0x67, 0x61, 0xd5,
+	0x24, 0xce, 0xb3, 0x45, 0xeb, 0x4f, 0x00, 0xc2, 0x84, 0x93, 0x75, 0xb0, 0x9c, 0x9b, 0x41, 0x02,
+	0x2f, 0x84, 0x78, 0x6d, 0x2e, 0x52, 0xdc, 0x7e, 0x9a, 0x85, 0xc5, 0x34, 0xb7, 0xcf, 0xc0, 0x77,
+	0x01, 0xed, 0x24, 0xd5, 0x20, 0xa7, 0xaa, 0xc1, 0xab, 0xd3, 0xaa, 0xc1, 0x44, 0xd4, 0x9d, 0x5d,
+	0x06, 0x7e, 0x73, 0x09, 0xf2, 0x1d, 0x1c, 0x60, 0x8f, 0xa3, 0xef, 0x4d, 0x34, 0x8c, 0xe1, 0x2d,
+	0xee, 0xea, 0x44, 0xcc, 0xb5, 0xf5, 0x23, 0x42, 0x18, 0x72, 0x1f, 0x9c, 0xd2, 0x2f, 0xbe, 0x04,
+	0xcb, 0xb2, 0xef, 0x8a, 0x5d, 0x09, 0x49, 0x5c, 0x52, 0x77, 0xca, 0xf8, 0x36, 0xc3, 0x51, 0x0d,
+	0x8a, 0x52, 0x2c, 0x29, 0x74, 0x52, 0x06, 0x3c, 0x7c, 0xb4, 0x1d, 0xce, 0xa0, 0xeb, 0x80, 0x0e,
+	0xe2, 0x47, 0x02, 0x2b, 0xa1, 0x40, 0xca, 0xad, 0x26, 0x2b, 0x91, 0xf8, 0xd7, 0x01, 0xa4, 0x15,
+	0x96, 0x43, 0x98, 0xef, 0xe9, 0x3b, 0xd5, 0x82, 0x9c, 0x69, 0xcb, 0x09, 0xf4, 0xcb, 0xb0, 0xf7,
+	0x1c, 0xbb, 0xad, 0xea, 0xb6, 0xff, 0xed, 0x8b, 0x45, 0xea, 0xd3, 0xe3, 0x5a, 0x65, 0x84, 0x3d,
+	0x77, 0xb3, 0x71, 0x0a, 0x64, 0x43, 0xf5, 0xa2, 0x27, 0x6f, 0xb9, 0xe8, 0x0d, 0x58, 0x1a, 0x0c,
+	0x7b, 0xd6, 0x21, 0x19, 0x59, 0x0a, 0xa5, 0x3c, 0x1f, 0x76, 0x80, 0x4f, 0x8f, 0x6b, 0x6b, 0x21,
+	0xd2, 0x89, 0xe5, 0x86, 0x59, 0x1c, 0xa8, 0x5b, 0xd6, 0xae, 0x1c, 0x21, 0x06, 0xcb, 0x72, 0xa3,
+	0x54, 0x0f, 0x5b, 0x50, 0x66, 0xbf, 0x75, 0xb1, 0x14, 0x7e, 0x7a, 0x5c, 0xbb, 0x92, 0x98, 0x9d,
+	0xa0, 0x35, 0xcc, 0x25, 0x8f, 0xb2, 0xd4, 0xd7, 0xb0, 0x07, 0x15, 0xec, 0xba, 0xfe, 0xbb, 0xd6,
+	0x2f, 0x48, 0xe0, 0x4f, 0xb4, 0xeb, 0xf2, 0x2a, 0x51, 0x68, 0xbd, 0xf4, 0xf4, 0xb8, 0xf6, 0x42,
+	0x88, 0x36, 0x5d, 0xb6, 0x61, 0x3e, 0xaf, 0x16, 0xdf, 0x21, 0x81, 0x7f, 0xb2, 0x3b, 0x4f, 0xe5,
+	0xf4, 0x87, 0x06, 0xa0, 0x64, 0xc1, 0x24, 0x7c, 0xe0, 0x33, 0xae, 0xae, 0x1d, 0xa9, 0x4d, 0x8d,
+	0xb3, 0xaf, 0x1d, 0x89, 0x7e, 0x74, 0xed, 0x48, 0x74, 0xd1, 0x77, 0x92, 0x92, 0x9f, 0xd5, 0x51,
+	0xad, 0x61, 0xe4, 0xf3, 0x55, 0xea, 0xea, 0x42, 0x23, 0xed, 0x89, 0xaa, 0x9e, 0x69, 0x7c, 0x6e,
+	0xc0, 0xd5, 0x89, 0xfc, 0x8a, 0x8d, 0xfd, 0x19, 0xa0, 0x20, 0xb5, 0xa8, 0xa2, 0x75, 0xa4, 0x8d,
+	0xbe, 0x70, 0xba, 0xae, 0x06, 0xe3, 0x0b, 0x5f, 0xda, 0x57, 0x2b, 0xa7, 0x4e, 0xe0, 0x4f, 0x06,
+	0xac, 0xa5, 0x8d, 0x89, 0xdd, 0xba, 0x03, 0x8b, 0x69, 0x5b, 0xb4, 0x43, 0x2f, 0x9e, 0xc7, 0x21,
+	0xed, 0xcb, 0x09, 0x7d, 0xf4, 0xc3, 0xa4, 0x94, 0x85, 0xcf, 0x75, 0x37, 0xce, 0xcd, 0x4d, 0x64,
+	0xd3, 0x78, 0x49, 0xcb, 0xa9, 0xd3, 0xf9, 0xbb, 0x01, 0xb9, 0x8e, 0xef, 0xbb, 0xe8, 0x00, 0x56,
+	0x99, 0x2f, 0x2c, 0x99, 0xf7, 0xc4, 0xb1, 0xf4, 0xdb, 0x81, 0x31, 0x03, 0xca, 0x4a, 0xcc, 0x17,
+	0x2d, 0x85, 0xba, 0xab, 0x40, 0x11, 0x86, 0xa5, 0x93, 0xbb, 0x64, 0x67, 0xb0, 0xcb, 0x62, 0x2f,
+	0xb5, 0xc5, 0x66, 0x41, 0x9e, 0xce, 0xbf, 0x1f, 0xd6, 0x8c, 0x6f, 0xfe, 0xde, 0x00, 0x48, 0x9e,
+	0x47, 0xd0, 0x6b, 0xf0, 0x7c, 0xeb, 0x07, 0x77, 0xda, 0x56, 0x77, 0xf7, 0xe6, 0xee, 0xdd, 0xae,
+	0x75, 0xf7, 0x4e, 0xb7, 0xb3, 0xbd, 0xb5, 0xf3, 0xe6, 0xce, 0x76, 0x7b, 0x25, 0x53, 0x29, 0xdd,
+	0x7f, 0x50, 0x2f, 0xde, 0x65, 0x7c, 0x40, 0x6c, 0xba, 0x4f, 0x89, 0x83, 0x5e, 0x86, 0xb5, 0x93,
+	0xd2, 0x72, 0xb4, 0xdd, 0x5e, 0x31, 0x2a, 0x8b, 0xf7, 0x1f, 0xd4, 0x0b, 0x61, 0x27, 0x48, 0x1c,
+	0x74, 0x0d, 0xae, 0x4c, 0xca, 0xed, 0xdc, 0x79, 0x6b, 0x25, 0x5b, 0x59, 0xba, 0xff, 0xa0, 0xbe,
+	0x10, 0xb7, 0x8c, 0xa8, 0x01, 0x28, 0x2d, 0xa9, 0xf1, 0xe6, 0x2a, 0x70, 0xff, 0x41, 0x3d, 0x1f,
+	0xb2, 0x54, 0xc9, 0xbd, 0xf7, 0x61, 0x35, 0xd3, 0x7a, 0xf3, 0x93, 0xc7, 0x55, 0xe3, 0xd1, 0xe3,
+	0xaa, 0xf1, 0xcf, 0xc7, 0x55, 0xe3, 0xfd, 0x27, 0xd5, 0xcc, 0xa3, 0x27, 0xd5, 0xcc, 0x9f, 0x9f,
+	0x54, 0x33, 0xef, 0xbc, 0x76, 0x26, 0x41, 0x47, 0xf1, 0x2b, 0xb9, 0xa2, 0xaa, 0x97, 0x57, 0x1f,
+	0xa0, 0x6f, 0xff, 0x6f, 0x00, 0xfb, 0x16, 0x8c, 0x7d, 0x44, 0x17, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1253,611 +1286,635 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9649 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x70, 0x24, 0xd7,
-		0x75, 0x1f, 0x8e, 0x9e, 0x17, 0x66, 0x0e, 0x06, 0xc0, 0xe0, 0x02, 0xbb, 0x9c, 0x1d, 0x92, 0x00,
-		0x38, 0x7c, 0xec, 0x72, 0x49, 0x62, 0xc9, 0x25, 0xf7, 0x35, 0x2b, 0x89, 0xc2, 0x00, 0xb3, 0x58,
-		0xec, 0xe2, 0xc5, 0x06, 0xb0, 0x7c, 0xc8, 0xfe, 0x4f, 0x35, 0x7a, 0x2e, 0x06, 0x4d, 0xf4, 0x74,
-		0xb7, 0xba, 0x7b, 0x96, 0x0b, 0xca, 0xfa, 0x17, 0x65, 0x25, 0x0e, 0xc5, 0x54, 0x12, 0x39, 0x4a,
-		0xc5, 0x92, 0xac, 0x55, 0x28, 0x4b, 0x89, 0x1c, 0x59, 0x4e, 0x64, 0x8b, 0x52, 0xfc, 0x48, 0x39,
-		0x92, 0x53, 0x8e, 0x25, 0x7d, 0x48, 0x49, 0x4e, 0x2a, 0xb6, 0x1c, 0x9b, 0x52, 0x28, 0x95, 0xad,
-		0x28, 0x4a, 0xac, 0x28, 0x4c, 0x39, 0x29, 0x95, 0x52, 0xa9, 0xfb, 0xea, 0xc7, 0xbc, 0x7a, 0x00,
-		0x62, 0x65, 0x3a, 0xfa, 0x84, 0xb9, 0xf7, 0x9e, 0xf3, 0xbb, 0xe7, 0x9e, 0x7b, 0xee, 0xb9, 0xe7,
-		0xbe, 0x1a, 0xf0, 0xaf, 0x2f, 0xc2, 0x74, 0xdd, 0x34, 0xeb, 0x3a, 0x3e, 0x65, 0xd9, 0xa6, 0x6b,
-		0x6e, 0x35, 0xb7, 0x4f, 0xd5, 0xb0, 0xa3, 0xda, 0x9a, 0xe5, 0x9a, 0xf6, 0x0c, 0xcd, 0x43, 0xa3,
-		0x8c, 0x62, 0x46, 0x50, 0x14, 0x97, 0x61, 0xec, 0x92, 0xa6, 0xe3, 0x79, 0x8f, 0x70, 0x1d, 0xbb,
-		0xe8, 0x3c, 0x24, 0xb6, 0x35, 0x1d, 0xe7, 0xa5, 0xe9, 0xf8, 0x89, 0xa1, 0xd3, 0xf7, 0xcc, 0xb4,
-		0x30, 0xcd, 0x84, 0x39, 0xd6, 0x48, 0xb6, 0x4c, 0x39, 0x8a, 0xdf, 0x4e, 0xc0, 0x78, 0x87, 0x52,
-		0x84, 0x20, 0x61, 0x28, 0x0d, 0x82, 0x28, 0x9d, 0xc8, 0xc8, 0xf4, 0x37, 0xca, 0xc3, 0xa0, 0xa5,
-		0xa8, 0xbb, 0x4a, 0x1d, 0xe7, 0x63, 0x34, 0x5b, 0x24, 0xd1, 0x24, 0x40, 0x0d, 0x5b, 0xd8, 0xa8,
-		0x61, 0x43, 0xdd, 0xcb, 0xc7, 0xa7, 0xe3, 0x27, 0x32, 0x72, 0x20, 0x07, 0x3d, 0x00, 0x63, 0x56,
-		0x73, 0x4b, 0xd7, 0xd4, 0x6a, 0x80, 0x0c, 0xa6, 0xe3, 0x27, 0x92, 0x72, 0x8e, 0x15, 0xcc, 0xfb,
-		0xc4, 0xc7, 0x61, 0xf4, 0x39, 0xac, 0xec, 0x06, 0x49, 0x87, 0x28, 0xe9, 0x08, 0xc9, 0x0e, 0x10,
-		0xce, 0x41, 0xb6, 0x81, 0x1d, 0x47, 0xa9, 0xe3, 0xaa, 0xbb, 0x67, 0xe1, 0x7c, 0x82, 0xb6, 0x7e,
-		0xba, 0xad, 0xf5, 0xad, 0x2d, 0x1f, 0xe2, 0x5c, 0x1b, 0x7b, 0x16, 0x46, 0xb3, 0x90, 0xc1, 0x46,
-		0xb3, 0xc1, 0x10, 0x92, 0x5d, 0xf4, 0x57, 0x31, 0x9a, 0x8d, 0x56, 0x94, 0x34, 0x61, 0xe3, 0x10,
-		0x83, 0x0e, 0xb6, 0xaf, 0x6b, 0x2a, 0xce, 0xa7, 0x28, 0xc0, 0xf1, 0x36, 0x80, 0x75, 0x56, 0xde,
-		0x8a, 0x21, 0xf8, 0xd0, 0x1c, 0x64, 0xf0, 0x0d, 0x17, 0x1b, 0x8e, 0x66, 0x1a, 0xf9, 0x41, 0x0a,
-		0x72, 0x6f, 0x87, 0x5e, 0xc4, 0x7a, 0xad, 0x15, 0xc2, 0xe7, 0x43, 0x67, 0x61, 0xd0, 0xb4, 0x5c,
-		0xcd, 0x34, 0x9c, 0x7c, 0x7a, 0x5a, 0x3a, 0x31, 0x74, 0xfa, 0x8e, 0x8e, 0x86, 0xb0, 0xca, 0x68,
-		0x64, 0x41, 0x8c, 0x16, 0x21, 0xe7, 0x98, 0x4d, 0x5b, 0xc5, 0x55, 0xd5, 0xac, 0xe1, 0xaa, 0x66,
-		0x6c, 0x9b, 0xf9, 0x0c, 0x05, 0x98, 0x6a, 0x6f, 0x08, 0x25, 0x9c, 0x33, 0x6b, 0x78, 0xd1, 0xd8,
-		0x36, 0xe5, 0x11, 0x27, 0x94, 0x46, 0x47, 0x21, 0xe5, 0xec, 0x19, 0xae, 0x72, 0x23, 0x9f, 0xa5,
-		0x16, 0xc2, 0x53, 0xc5, 0xdf, 0x4c, 0xc1, 0x68, 0x3f, 0x26, 0x76, 0x11, 0x92, 0xdb, 0xa4, 0x95,
-		0xf9, 0xd8, 0x7e, 0x74, 0xc0, 0x78, 0xc2, 0x4a, 0x4c, 0x1d, 0x50, 0x89, 0xb3, 0x30, 0x64, 0x60,
-		0xc7, 0xc5, 0x35, 0x66, 0x11, 0xf1, 0x3e, 0x6d, 0x0a, 0x18, 0x53, 0xbb, 0x49, 0x25, 0x0e, 0x64,
-		0x52, 0x4f, 0xc1, 0xa8, 0x27, 0x52, 0xd5, 0x56, 0x8c, 0xba, 0xb0, 0xcd, 0x53, 0x51, 0x92, 0xcc,
-		0x54, 0x04, 0x9f, 0x4c, 0xd8, 0xe4, 0x11, 0x1c, 0x4a, 0xa3, 0x79, 0x00, 0xd3, 0xc0, 0xe6, 0x76,
-		0xb5, 0x86, 0x55, 0x3d, 0x9f, 0xee, 0xa2, 0xa5, 0x55, 0x42, 0xd2, 0xa6, 0x25, 0x93, 0xe5, 0xaa,
-		0x3a, 0xba, 0xe0, 0x9b, 0xda, 0x60, 0x17, 0x4b, 0x59, 0x66, 0x83, 0xac, 0xcd, 0xda, 0x36, 0x61,
-		0xc4, 0xc6, 0xc4, 0xee, 0x71, 0x8d, 0xb7, 0x2c, 0x43, 0x85, 0x98, 0x89, 0x6c, 0x99, 0xcc, 0xd9,
-		0x58, 0xc3, 0x86, 0xed, 0x60, 0x12, 0xdd, 0x0d, 0x5e, 0x46, 0x95, 0x9a, 0x15, 0x50, 0x2f, 0x94,
-		0x15, 0x99, 0x2b, 0x4a, 0x03, 0x17, 0x9e, 0x87, 0x91, 0xb0, 0x7a, 0xd0, 0x04, 0x24, 0x1d, 0x57,
-		0xb1, 0x5d, 0x6a, 0x85, 0x49, 0x99, 0x25, 0x50, 0x0e, 0xe2, 0xd8, 0xa8, 0x51, 0x2f, 0x97, 0x94,
-		0xc9, 0x4f, 0xf4, 0x76, 0xbf, 0xc1, 0x71, 0xda, 0xe0, 0xfb, 0xda, 0x7b, 0x34, 0x84, 0xdc, 0xda,
-		0xee, 0xc2, 0x39, 0x18, 0x0e, 0x35, 0xa0, 0xdf, 0xaa, 0x8b, 0x3f, 0x03, 0x47, 0x3a, 0x42, 0xa3,
-		0xa7, 0x60, 0xa2, 0x69, 0x68, 0x86, 0x8b, 0x6d, 0xcb, 0xc6, 0xc4, 0x62, 0x59, 0x55, 0xf9, 0x3f,
-		0x1f, 0xec, 0x62, 0x73, 0x9b, 0x41, 0x6a, 0x86, 0x22, 0x8f, 0x37, 0xdb, 0x33, 0x4f, 0x66, 0xd2,
-		0xdf, 0x19, 0xcc, 0xbd, 0xf0, 0xc2, 0x0b, 0x2f, 0xc4, 0x8a, 0x5f, 0x4c, 0xc1, 0x44, 0xa7, 0x31,
-		0xd3, 0x71, 0xf8, 0x1e, 0x85, 0x94, 0xd1, 0x6c, 0x6c, 0x61, 0x9b, 0x2a, 0x29, 0x29, 0xf3, 0x14,
-		0x9a, 0x85, 0xa4, 0xae, 0x6c, 0x61, 0x3d, 0x9f, 0x98, 0x96, 0x4e, 0x8c, 0x9c, 0x7e, 0xa0, 0xaf,
-		0x51, 0x39, 0xb3, 0x44, 0x58, 0x64, 0xc6, 0x89, 0xde, 0x06, 0x09, 0xee, 0xa2, 0x09, 0xc2, 0xc9,
-		0xfe, 0x10, 0xc8, 0x58, 0x92, 0x29, 0x1f, 0xba, 0x1d, 0x32, 0xe4, 0x2f, 0xb3, 0x8d, 0x14, 0x95,
-		0x39, 0x4d, 0x32, 0x88, 0x5d, 0xa0, 0x02, 0xa4, 0xe9, 0x30, 0xa9, 0x61, 0x31, 0xb5, 0x79, 0x69,
-		0x62, 0x58, 0x35, 0xbc, 0xad, 0x34, 0x75, 0xb7, 0x7a, 0x5d, 0xd1, 0x9b, 0x98, 0x1a, 0x7c, 0x46,
-		0xce, 0xf2, 0xcc, 0x6b, 0x24, 0x0f, 0x4d, 0xc1, 0x10, 0x1b, 0x55, 0x9a, 0x51, 0xc3, 0x37, 0xa8,
-		0xf7, 0x4c, 0xca, 0x6c, 0xa0, 0x2d, 0x92, 0x1c, 0x52, 0xfd, 0xb3, 0x8e, 0x69, 0x08, 0xd3, 0xa4,
-		0x55, 0x90, 0x0c, 0x5a, 0xfd, 0xb9, 0x56, 0xc7, 0x7d, 0x67, 0xe7, 0xe6, 0xb5, 0x8d, 0xa5, 0xe3,
-		0x30, 0x4a, 0x29, 0x1e, 0xe5, 0x5d, 0xaf, 0xe8, 0xf9, 0xb1, 0x69, 0xe9, 0x44, 0x5a, 0x1e, 0x61,
-		0xd9, 0xab, 0x3c, 0xb7, 0xf8, 0xf9, 0x18, 0x24, 0xa8, 0x63, 0x19, 0x85, 0xa1, 0x8d, 0xa7, 0xd7,
-		0x2a, 0xd5, 0xf9, 0xd5, 0xcd, 0xf2, 0x52, 0x25, 0x27, 0xa1, 0x11, 0x00, 0x9a, 0x71, 0x69, 0x69,
-		0x75, 0x76, 0x23, 0x17, 0xf3, 0xd2, 0x8b, 0x2b, 0x1b, 0x67, 0x1f, 0xcb, 0xc5, 0x3d, 0x86, 0x4d,
-		0x96, 0x91, 0x08, 0x12, 0x3c, 0x7a, 0x3a, 0x97, 0x44, 0x39, 0xc8, 0x32, 0x80, 0xc5, 0xa7, 0x2a,
-		0xf3, 0x67, 0x1f, 0xcb, 0xa5, 0xc2, 0x39, 0x8f, 0x9e, 0xce, 0x0d, 0xa2, 0x61, 0xc8, 0xd0, 0x9c,
-		0xf2, 0xea, 0xea, 0x52, 0x2e, 0xed, 0x61, 0xae, 0x6f, 0xc8, 0x8b, 0x2b, 0x0b, 0xb9, 0x8c, 0x87,
-		0xb9, 0x20, 0xaf, 0x6e, 0xae, 0xe5, 0xc0, 0x43, 0x58, 0xae, 0xac, 0xaf, 0xcf, 0x2e, 0x54, 0x72,
-		0x43, 0x1e, 0x45, 0xf9, 0xe9, 0x8d, 0xca, 0x7a, 0x2e, 0x1b, 0x12, 0xeb, 0xd1, 0xd3, 0xb9, 0x61,
-		0xaf, 0x8a, 0xca, 0xca, 0xe6, 0x72, 0x6e, 0x04, 0x8d, 0xc1, 0x30, 0xab, 0x42, 0x08, 0x31, 0xda,
-		0x92, 0x75, 0xf6, 0xb1, 0x5c, 0xce, 0x17, 0x84, 0xa1, 0x8c, 0x85, 0x32, 0xce, 0x3e, 0x96, 0x43,
-		0xc5, 0x39, 0x48, 0x52, 0x33, 0x44, 0x08, 0x46, 0x96, 0x66, 0xcb, 0x95, 0xa5, 0xea, 0xea, 0xda,
-		0xc6, 0xe2, 0xea, 0xca, 0xec, 0x52, 0x4e, 0xf2, 0xf3, 0xe4, 0xca, 0x13, 0x9b, 0x8b, 0x72, 0x65,
-		0x3e, 0x17, 0x0b, 0xe6, 0xad, 0x55, 0x66, 0x37, 0x2a, 0xf3, 0xb9, 0x78, 0x51, 0x85, 0x89, 0x4e,
-		0x0e, 0xb5, 0xe3, 0x10, 0x0a, 0xd8, 0x42, 0xac, 0x8b, 0x2d, 0x50, 0xac, 0x56, 0x5b, 0x28, 0x7e,
-		0x2b, 0x06, 0xe3, 0x1d, 0x26, 0x95, 0x8e, 0x95, 0x3c, 0x0e, 0x49, 0x66, 0xcb, 0x6c, 0x9a, 0xbd,
-		0xbf, 0xe3, 0xec, 0x44, 0x2d, 0xbb, 0x6d, 0xaa, 0xa5, 0x7c, 0xc1, 0x50, 0x23, 0xde, 0x25, 0xd4,
-		0x20, 0x10, 0x6d, 0x06, 0xfb, 0xd3, 0x6d, 0xce, 0x9f, 0xcd, 0x8f, 0x67, 0xfb, 0x99, 0x1f, 0x69,
-		0xde, 0xfe, 0x26, 0x81, 0x64, 0x87, 0x49, 0xe0, 0x22, 0x8c, 0xb5, 0x01, 0xf5, 0xed, 0x8c, 0xdf,
-		0x2b, 0x41, 0xbe, 0x9b, 0x72, 0x22, 0x5c, 0x62, 0x2c, 0xe4, 0x12, 0x2f, 0xb6, 0x6a, 0xf0, 0xae,
-		0xee, 0x9d, 0xd0, 0xd6, 0xd7, 0x9f, 0x94, 0xe0, 0x68, 0xe7, 0x90, 0xb2, 0xa3, 0x0c, 0x6f, 0x83,
-		0x54, 0x03, 0xbb, 0x3b, 0xa6, 0x08, 0xab, 0xee, 0xeb, 0x30, 0x59, 0x93, 0xe2, 0xd6, 0xce, 0xe6,
-		0x5c, 0xc1, 0xd9, 0x3e, 0xde, 0x2d, 0x2e, 0x64, 0xd2, 0xb4, 0x49, 0xfa, 0xbe, 0x18, 0x1c, 0xe9,
-		0x08, 0xde, 0x51, 0xd0, 0x3b, 0x01, 0x34, 0xc3, 0x6a, 0xba, 0x2c, 0x74, 0x62, 0x9e, 0x38, 0x43,
-		0x73, 0xa8, 0xf3, 0x22, 0x5e, 0xb6, 0xe9, 0x7a, 0xe5, 0x71, 0x5a, 0x0e, 0x2c, 0x8b, 0x12, 0x9c,
-		0xf7, 0x05, 0x4d, 0x50, 0x41, 0x27, 0xbb, 0xb4, 0xb4, 0xcd, 0x30, 0x1f, 0x86, 0x9c, 0xaa, 0x6b,
-		0xd8, 0x70, 0xab, 0x8e, 0x6b, 0x63, 0xa5, 0xa1, 0x19, 0x75, 0x3a, 0xd5, 0xa4, 0x4b, 0xc9, 0x6d,
-		0x45, 0x77, 0xb0, 0x3c, 0xca, 0x8a, 0xd7, 0x45, 0x29, 0xe1, 0xa0, 0x06, 0x64, 0x07, 0x38, 0x52,
-		0x21, 0x0e, 0x56, 0xec, 0x71, 0x14, 0x7f, 0x3e, 0x03, 0x43, 0x81, 0x00, 0x1c, 0xdd, 0x05, 0xd9,
-		0x67, 0x95, 0xeb, 0x4a, 0x55, 0x2c, 0xaa, 0x98, 0x26, 0x86, 0x48, 0xde, 0x1a, 0x5f, 0x58, 0x3d,
-		0x0c, 0x13, 0x94, 0xc4, 0x6c, 0xba, 0xd8, 0xae, 0xaa, 0xba, 0xe2, 0x38, 0x54, 0x69, 0x69, 0x4a,
-		0x8a, 0x48, 0xd9, 0x2a, 0x29, 0x9a, 0x13, 0x25, 0xe8, 0x0c, 0x8c, 0x53, 0x8e, 0x46, 0x53, 0x77,
-		0x35, 0x4b, 0xc7, 0x55, 0xb2, 0xcc, 0x73, 0xe8, 0x94, 0xe3, 0x49, 0x36, 0x46, 0x28, 0x96, 0x39,
-		0x01, 0x91, 0xc8, 0x41, 0xf3, 0x70, 0x27, 0x65, 0xab, 0x63, 0x03, 0xdb, 0x8a, 0x8b, 0xab, 0xf8,
-		0x9d, 0x4d, 0x45, 0x77, 0xaa, 0x8a, 0x51, 0xab, 0xee, 0x28, 0xce, 0x4e, 0x7e, 0x82, 0x00, 0x94,
-		0x63, 0x79, 0x49, 0x3e, 0x46, 0x08, 0x17, 0x38, 0x5d, 0x85, 0x92, 0xcd, 0x1a, 0xb5, 0xcb, 0x8a,
-		0xb3, 0x83, 0x4a, 0x70, 0x94, 0xa2, 0x38, 0xae, 0xad, 0x19, 0xf5, 0xaa, 0xba, 0x83, 0xd5, 0xdd,
-		0x6a, 0xd3, 0xdd, 0x3e, 0x9f, 0xbf, 0x3d, 0x58, 0x3f, 0x95, 0x70, 0x9d, 0xd2, 0xcc, 0x11, 0x92,
-		0x4d, 0x77, 0xfb, 0x3c, 0x5a, 0x87, 0x2c, 0xe9, 0x8c, 0x86, 0xf6, 0x3c, 0xae, 0x6e, 0x9b, 0x36,
-		0x9d, 0x43, 0x47, 0x3a, 0xb8, 0xa6, 0x80, 0x06, 0x67, 0x56, 0x39, 0xc3, 0xb2, 0x59, 0xc3, 0xa5,
-		0xe4, 0xfa, 0x5a, 0xa5, 0x32, 0x2f, 0x0f, 0x09, 0x94, 0x4b, 0xa6, 0x4d, 0x0c, 0xaa, 0x6e, 0x7a,
-		0x0a, 0x1e, 0x62, 0x06, 0x55, 0x37, 0x85, 0x7a, 0xcf, 0xc0, 0xb8, 0xaa, 0xb2, 0x36, 0x6b, 0x6a,
-		0x95, 0x2f, 0xc6, 0x9c, 0x7c, 0x2e, 0xa4, 0x2c, 0x55, 0x5d, 0x60, 0x04, 0xdc, 0xc6, 0x1d, 0x74,
-		0x01, 0x8e, 0xf8, 0xca, 0x0a, 0x32, 0x8e, 0xb5, 0xb5, 0xb2, 0x95, 0xf5, 0x0c, 0x8c, 0x5b, 0x7b,
-		0xed, 0x8c, 0x28, 0x54, 0xa3, 0xb5, 0xd7, 0xca, 0x76, 0x0e, 0x26, 0xac, 0x1d, 0xab, 0x9d, 0xef,
-		0x64, 0x90, 0x0f, 0x59, 0x3b, 0x56, 0x2b, 0xe3, 0xbd, 0x74, 0x65, 0x6e, 0x63, 0x55, 0x71, 0x71,
-		0x2d, 0x7f, 0x5b, 0x90, 0x3c, 0x50, 0x80, 0x66, 0x20, 0xa7, 0xaa, 0x55, 0x6c, 0x28, 0x5b, 0x3a,
-		0xae, 0x2a, 0x36, 0x36, 0x14, 0x27, 0x3f, 0x45, 0x89, 0x13, 0xae, 0xdd, 0xc4, 0xf2, 0x88, 0xaa,
-		0x56, 0x68, 0xe1, 0x2c, 0x2d, 0x43, 0x27, 0x61, 0xcc, 0xdc, 0x7a, 0x56, 0x65, 0x16, 0x59, 0xb5,
-		0x6c, 0xbc, 0xad, 0xdd, 0xc8, 0xdf, 0x43, 0xd5, 0x3b, 0x4a, 0x0a, 0xa8, 0x3d, 0xae, 0xd1, 0x6c,
-		0x74, 0x3f, 0xe4, 0x54, 0x67, 0x47, 0xb1, 0x2d, 0xea, 0x92, 0x1d, 0x4b, 0x51, 0x71, 0xfe, 0x5e,
-		0x46, 0xca, 0xf2, 0x57, 0x44, 0x36, 0x19, 0x11, 0xce, 0x73, 0xda, 0xb6, 0x2b, 0x10, 0x8f, 0xb3,
-		0x11, 0x41, 0xf3, 0x38, 0xda, 0x09, 0xc8, 0x11, 0x4d, 0x84, 0x2a, 0x3e, 0x41, 0xc9, 0x46, 0xac,
-		0x1d, 0x2b, 0x58, 0xef, 0xdd, 0x30, 0x4c, 0x28, 0xfd, 0x4a, 0xef, 0x67, 0x81, 0x9b, 0xb5, 0x13,
-		0xa8, 0xf1, 0x31, 0x38, 0x4a, 0x88, 0x1a, 0xd8, 0x55, 0x6a, 0x8a, 0xab, 0x04, 0xa8, 0x1f, 0xa4,
-		0xd4, 0x44, 0xed, 0xcb, 0xbc, 0x30, 0x24, 0xa7, 0xdd, 0xdc, 0xda, 0xf3, 0x0c, 0xeb, 0x21, 0x26,
-		0x27, 0xc9, 0x13, 0xa6, 0x75, 0xcb, 0x82, 0xf3, 0x62, 0x09, 0xb2, 0x41, 0xbb, 0x47, 0x19, 0x60,
-		0x96, 0x9f, 0x93, 0x48, 0x10, 0x34, 0xb7, 0x3a, 0x4f, 0xc2, 0x97, 0x67, 0x2a, 0xb9, 0x18, 0x09,
-		0xa3, 0x96, 0x16, 0x37, 0x2a, 0x55, 0x79, 0x73, 0x65, 0x63, 0x71, 0xb9, 0x92, 0x8b, 0x07, 0x02,
-		0xfb, 0x2b, 0x89, 0xf4, 0x7d, 0xb9, 0xe3, 0x24, 0x6a, 0x18, 0x09, 0xaf, 0xd4, 0xd0, 0x5b, 0xe0,
-		0x36, 0xb1, 0xad, 0xe2, 0x60, 0xb7, 0xfa, 0x9c, 0x66, 0xd3, 0x01, 0xd9, 0x50, 0xd8, 0xe4, 0xe8,
-		0xd9, 0xcf, 0x04, 0xa7, 0x5a, 0xc7, 0xee, 0x93, 0x9a, 0x4d, 0x86, 0x5b, 0x43, 0x71, 0xd1, 0x12,
-		0x4c, 0x19, 0x66, 0xd5, 0x71, 0x15, 0xa3, 0xa6, 0xd8, 0xb5, 0xaa, 0xbf, 0xa1, 0x55, 0x55, 0x54,
-		0x15, 0x3b, 0x8e, 0xc9, 0x26, 0x42, 0x0f, 0xe5, 0x0e, 0xc3, 0x5c, 0xe7, 0xc4, 0xfe, 0x0c, 0x31,
-		0xcb, 0x49, 0x5b, 0xcc, 0x37, 0xde, 0xcd, 0x7c, 0x6f, 0x87, 0x4c, 0x43, 0xb1, 0xaa, 0xd8, 0x70,
-		0xed, 0x3d, 0x1a, 0x9f, 0xa7, 0xe5, 0x74, 0x43, 0xb1, 0x2a, 0x24, 0xfd, 0x63, 0x59, 0x26, 0x5d,
-		0x49, 0xa4, 0x13, 0xb9, 0xe4, 0x95, 0x44, 0x3a, 0x99, 0x4b, 0x5d, 0x49, 0xa4, 0x53, 0xb9, 0xc1,
-		0x2b, 0x89, 0x74, 0x3a, 0x97, 0xb9, 0x92, 0x48, 0x67, 0x72, 0x50, 0x7c, 0x2d, 0x0e, 0xd9, 0x60,
-		0x04, 0x4f, 0x16, 0x44, 0x2a, 0x9d, 0xc3, 0x24, 0xea, 0xe5, 0xee, 0xee, 0x19, 0xef, 0xcf, 0xcc,
-		0x91, 0xc9, 0xad, 0x94, 0x62, 0xe1, 0xb2, 0xcc, 0x38, 0x49, 0x60, 0x41, 0xcc, 0x0f, 0xb3, 0xf0,
-		0x24, 0x2d, 0xf3, 0x14, 0x5a, 0x80, 0xd4, 0xb3, 0x0e, 0xc5, 0x4e, 0x51, 0xec, 0x7b, 0x7a, 0x63,
-		0x5f, 0x59, 0xa7, 0xe0, 0x99, 0x2b, 0xeb, 0xd5, 0x95, 0x55, 0x79, 0x79, 0x76, 0x49, 0xe6, 0xec,
-		0xe8, 0x18, 0x24, 0x74, 0xe5, 0xf9, 0xbd, 0xf0, 0x34, 0x48, 0xb3, 0xfa, 0xed, 0x96, 0x63, 0x90,
-		0x78, 0x0e, 0x2b, 0xbb, 0xe1, 0xc9, 0x87, 0x66, 0xdd, 0xc2, 0xe1, 0x71, 0x0a, 0x92, 0x54, 0x5f,
-		0x08, 0x80, 0x6b, 0x2c, 0x37, 0x80, 0xd2, 0x90, 0x98, 0x5b, 0x95, 0xc9, 0x10, 0xc9, 0x41, 0x96,
-		0xe5, 0x56, 0xd7, 0x16, 0x2b, 0x73, 0x95, 0x5c, 0xac, 0x78, 0x06, 0x52, 0x4c, 0x09, 0x64, 0xf8,
-		0x78, 0x6a, 0xc8, 0x0d, 0xf0, 0x24, 0xc7, 0x90, 0x44, 0xe9, 0xe6, 0x72, 0xb9, 0x22, 0xe7, 0x62,
-		0x6d, 0x9d, 0x5f, 0x74, 0x20, 0x1b, 0x8c, 0xcc, 0x7f, 0x3c, 0xcb, 0xf3, 0x2f, 0x48, 0x30, 0x14,
-		0x88, 0xb4, 0x49, 0x88, 0xa4, 0xe8, 0xba, 0xf9, 0x5c, 0x55, 0xd1, 0x35, 0xc5, 0xe1, 0xa6, 0x01,
-		0x34, 0x6b, 0x96, 0xe4, 0xf4, 0xdb, 0x75, 0x3f, 0xa6, 0x41, 0x93, 0xcc, 0xa5, 0x8a, 0x1f, 0x95,
-		0x20, 0xd7, 0x1a, 0xea, 0xb6, 0x88, 0x29, 0xfd, 0x55, 0x8a, 0x59, 0xfc, 0x88, 0x04, 0x23, 0xe1,
-		0xf8, 0xb6, 0x45, 0xbc, 0xbb, 0xfe, 0x4a, 0xc5, 0xfb, 0x66, 0x0c, 0x86, 0x43, 0x51, 0x6d, 0xbf,
-		0xd2, 0xbd, 0x13, 0xc6, 0xb4, 0x1a, 0x6e, 0x58, 0xa6, 0x8b, 0x0d, 0x75, 0xaf, 0xaa, 0xe3, 0xeb,
-		0x58, 0xcf, 0x17, 0xa9, 0xd3, 0x38, 0xd5, 0x3b, 0x6e, 0x9e, 0x59, 0xf4, 0xf9, 0x96, 0x08, 0x5b,
-		0x69, 0x7c, 0x71, 0xbe, 0xb2, 0xbc, 0xb6, 0xba, 0x51, 0x59, 0x99, 0x7b, 0xba, 0xba, 0xb9, 0x72,
-		0x75, 0x65, 0xf5, 0xc9, 0x15, 0x39, 0xa7, 0xb5, 0x90, 0xdd, 0xc2, 0x61, 0xbf, 0x06, 0xb9, 0x56,
-		0xa1, 0xd0, 0x6d, 0xd0, 0x49, 0xac, 0xdc, 0x00, 0x1a, 0x87, 0xd1, 0x95, 0xd5, 0xea, 0xfa, 0xe2,
-		0x7c, 0xa5, 0x5a, 0xb9, 0x74, 0xa9, 0x32, 0xb7, 0xb1, 0xce, 0x76, 0x42, 0x3c, 0xea, 0x8d, 0xd0,
-		0x00, 0x2f, 0x7e, 0x38, 0x0e, 0xe3, 0x1d, 0x24, 0x41, 0xb3, 0x7c, 0x0d, 0xc3, 0x96, 0x55, 0x0f,
-		0xf5, 0x23, 0xfd, 0x0c, 0x89, 0x22, 0xd6, 0x14, 0xdb, 0xe5, 0x4b, 0x9e, 0xfb, 0x81, 0x68, 0xc9,
-		0x70, 0xb5, 0x6d, 0x0d, 0xdb, 0x7c, 0x87, 0x89, 0x2d, 0x6c, 0x46, 0xfd, 0x7c, 0xb6, 0xc9, 0xf4,
-		0x20, 0x20, 0xcb, 0x74, 0x34, 0x57, 0xbb, 0x8e, 0xab, 0x9a, 0x21, 0xb6, 0xa3, 0xc8, 0x42, 0x27,
-		0x21, 0xe7, 0x44, 0xc9, 0xa2, 0xe1, 0x7a, 0xd4, 0x06, 0xae, 0x2b, 0x2d, 0xd4, 0xc4, 0x99, 0xc7,
-		0xe5, 0x9c, 0x28, 0xf1, 0xa8, 0xef, 0x82, 0x6c, 0xcd, 0x6c, 0x92, 0xe8, 0x8f, 0xd1, 0x91, 0xb9,
-		0x43, 0x92, 0x87, 0x58, 0x9e, 0x47, 0xc2, 0xe3, 0x7a, 0x7f, 0x1f, 0x2c, 0x2b, 0x0f, 0xb1, 0x3c,
-		0x46, 0x72, 0x1c, 0x46, 0x95, 0x7a, 0xdd, 0x26, 0xe0, 0x02, 0x88, 0xad, 0x54, 0x46, 0xbc, 0x6c,
-		0x4a, 0x58, 0xb8, 0x02, 0x69, 0xa1, 0x07, 0x32, 0x79, 0x13, 0x4d, 0x54, 0x2d, 0xb6, 0xfc, 0x8e,
-		0x9d, 0xc8, 0xc8, 0x69, 0x43, 0x14, 0xde, 0x05, 0x59, 0xcd, 0xa9, 0xfa, 0xdb, 0xfa, 0xb1, 0xe9,
-		0xd8, 0x89, 0xb4, 0x3c, 0xa4, 0x39, 0xde, 0x96, 0x68, 0xf1, 0x93, 0x31, 0x18, 0x09, 0x1f, 0x4b,
-		0xa0, 0x79, 0x48, 0xeb, 0xa6, 0xaa, 0x50, 0xd3, 0x62, 0x67, 0x62, 0x27, 0x22, 0x4e, 0x32, 0x66,
-		0x96, 0x38, 0xbd, 0xec, 0x71, 0x16, 0xfe, 0xad, 0x04, 0x69, 0x91, 0x8d, 0x8e, 0x42, 0xc2, 0x52,
-		0xdc, 0x1d, 0x0a, 0x97, 0x2c, 0xc7, 0x72, 0x92, 0x4c, 0xd3, 0x24, 0xdf, 0xb1, 0x14, 0x83, 0x9a,
-		0x00, 0xcf, 0x27, 0x69, 0xd2, 0xaf, 0x3a, 0x56, 0x6a, 0x74, 0x19, 0x64, 0x36, 0x1a, 0xd8, 0x70,
-		0x1d, 0xd1, 0xaf, 0x3c, 0x7f, 0x8e, 0x67, 0xa3, 0x07, 0x60, 0xcc, 0xb5, 0x15, 0x4d, 0x0f, 0xd1,
-		0x26, 0x28, 0x6d, 0x4e, 0x14, 0x78, 0xc4, 0x25, 0x38, 0x26, 0x70, 0x6b, 0xd8, 0x55, 0xd4, 0x1d,
-		0x5c, 0xf3, 0x99, 0x52, 0x74, 0xbb, 0xe3, 0x36, 0x4e, 0x30, 0xcf, 0xcb, 0x05, 0x6f, 0xf1, 0x6b,
-		0x12, 0x8c, 0x89, 0x85, 0x5b, 0xcd, 0x53, 0xd6, 0x32, 0x80, 0x62, 0x18, 0xa6, 0x1b, 0x54, 0x57,
-		0xbb, 0x29, 0xb7, 0xf1, 0xcd, 0xcc, 0x7a, 0x4c, 0x72, 0x00, 0xa0, 0xd0, 0x00, 0xf0, 0x4b, 0xba,
-		0xaa, 0x6d, 0x0a, 0x86, 0xf8, 0x99, 0x13, 0x3d, 0xb8, 0x64, 0x4b, 0x7d, 0x60, 0x59, 0x64, 0x85,
-		0x87, 0x26, 0x20, 0xb9, 0x85, 0xeb, 0x9a, 0xc1, 0x77, 0x92, 0x59, 0x42, 0x6c, 0xc8, 0x24, 0xbc,
-		0x0d, 0x99, 0xf2, 0xff, 0x0f, 0xe3, 0xaa, 0xd9, 0x68, 0x15, 0xb7, 0x9c, 0x6b, 0xd9, 0x6e, 0x70,
-		0x2e, 0x4b, 0xcf, 0x3c, 0xc4, 0x89, 0xea, 0xa6, 0xae, 0x18, 0xf5, 0x19, 0xd3, 0xae, 0xfb, 0x07,
-		0xaf, 0x24, 0xe2, 0x71, 0x02, 0xc7, 0xaf, 0xd6, 0xd6, 0xff, 0x92, 0xa4, 0x5f, 0x8a, 0xc5, 0x17,
-		0xd6, 0xca, 0x9f, 0x8a, 0x15, 0x16, 0x18, 0xe3, 0x9a, 0x50, 0x86, 0x8c, 0xb7, 0x75, 0xac, 0x92,
-		0x06, 0xc2, 0x77, 0x1f, 0x80, 0x89, 0xba, 0x59, 0x37, 0x29, 0xd2, 0x29, 0xf2, 0x8b, 0x9f, 0xdc,
-		0x66, 0xbc, 0xdc, 0x42, 0xe4, 0x31, 0x6f, 0x69, 0x05, 0xc6, 0x39, 0x71, 0x95, 0x1e, 0x1d, 0xb1,
-		0x85, 0x0d, 0xea, 0xb9, 0xab, 0x96, 0xff, 0xb5, 0x6f, 0xd3, 0xe9, 0x5b, 0x1e, 0xe3, 0xac, 0xa4,
-		0x8c, 0xad, 0x7d, 0x4a, 0x32, 0x1c, 0x09, 0xe1, 0xb1, 0x41, 0x8a, 0xed, 0x08, 0xc4, 0xdf, 0xe3,
-		0x88, 0xe3, 0x01, 0xc4, 0x75, 0xce, 0x5a, 0x9a, 0x83, 0xe1, 0xfd, 0x60, 0xfd, 0x1b, 0x8e, 0x95,
-		0xc5, 0x41, 0x90, 0x05, 0x18, 0xa5, 0x20, 0x6a, 0xd3, 0x71, 0xcd, 0x06, 0xf5, 0x80, 0xbd, 0x61,
-		0x7e, 0xff, 0xdb, 0x6c, 0xd4, 0x8c, 0x10, 0xb6, 0x39, 0x8f, 0xab, 0x54, 0x02, 0x7a, 0x5a, 0x56,
-		0xc3, 0xaa, 0x1e, 0x81, 0xf0, 0x25, 0x2e, 0x88, 0x47, 0x5f, 0xba, 0x06, 0x13, 0xe4, 0x37, 0x75,
-		0x50, 0x41, 0x49, 0xa2, 0xb7, 0xe0, 0xf2, 0x5f, 0x7b, 0x2f, 0x1b, 0x98, 0xe3, 0x1e, 0x40, 0x40,
-		0xa6, 0x40, 0x2f, 0xd6, 0xb1, 0xeb, 0x62, 0xdb, 0xa9, 0x2a, 0x7a, 0x27, 0xf1, 0x02, 0x7b, 0x18,
-		0xf9, 0x0f, 0x7d, 0x2f, 0xdc, 0x8b, 0x0b, 0x8c, 0x73, 0x56, 0xd7, 0x4b, 0x9b, 0x70, 0x5b, 0x07,
-		0xab, 0xe8, 0x03, 0xf3, 0xc3, 0x1c, 0x73, 0xa2, 0xcd, 0x32, 0x08, 0xec, 0x1a, 0x88, 0x7c, 0xaf,
-		0x2f, 0xfb, 0xc0, 0xfc, 0x45, 0x8e, 0x89, 0x38, 0xaf, 0xe8, 0x52, 0x82, 0x78, 0x05, 0xc6, 0xae,
-		0x63, 0x7b, 0xcb, 0x74, 0xf8, 0xbe, 0x51, 0x1f, 0x70, 0x1f, 0xe1, 0x70, 0xa3, 0x9c, 0x91, 0x6e,
-		0x24, 0x11, 0xac, 0x0b, 0x90, 0xde, 0x56, 0x54, 0xdc, 0x07, 0xc4, 0x4d, 0x0e, 0x31, 0x48, 0xe8,
-		0x09, 0xeb, 0x2c, 0x64, 0xeb, 0x26, 0x9f, 0xa3, 0xa2, 0xd9, 0x3f, 0xca, 0xd9, 0x87, 0x04, 0x0f,
-		0x87, 0xb0, 0x4c, 0xab, 0xa9, 0x93, 0x09, 0x2c, 0x1a, 0xe2, 0x1f, 0x09, 0x08, 0xc1, 0xc3, 0x21,
-		0xf6, 0xa1, 0xd6, 0x97, 0x05, 0x84, 0x13, 0xd0, 0xe7, 0xe3, 0x30, 0x64, 0x1a, 0xfa, 0x9e, 0x69,
-		0xf4, 0x23, 0xc4, 0xc7, 0x38, 0x02, 0x70, 0x16, 0x02, 0x70, 0x11, 0x32, 0xfd, 0x76, 0xc4, 0x3f,
-		0xfe, 0x9e, 0x18, 0x1e, 0xa2, 0x07, 0x16, 0x60, 0x54, 0x38, 0x28, 0xcd, 0x34, 0xfa, 0x80, 0xf8,
-		0x27, 0x1c, 0x62, 0x24, 0xc0, 0xc6, 0x9b, 0xe1, 0x62, 0xc7, 0xad, 0xe3, 0x7e, 0x40, 0x3e, 0x29,
-		0x9a, 0xc1, 0x59, 0xb8, 0x2a, 0xb7, 0xb0, 0xa1, 0xee, 0xf4, 0x87, 0xf0, 0xcb, 0x42, 0x95, 0x82,
-		0x87, 0x40, 0xcc, 0xc1, 0x70, 0x43, 0xb1, 0x9d, 0x1d, 0x45, 0xef, 0xab, 0x3b, 0xfe, 0x29, 0xc7,
-		0xc8, 0x7a, 0x4c, 0x5c, 0x23, 0x4d, 0x63, 0x3f, 0x30, 0x9f, 0x12, 0x1a, 0x09, 0xb0, 0xf1, 0xa1,
-		0xe7, 0xb8, 0x74, 0x93, 0x6d, 0x3f, 0x68, 0xbf, 0x22, 0x86, 0x1e, 0xe3, 0x5d, 0x0e, 0x22, 0x5e,
-		0x84, 0x8c, 0xa3, 0x3d, 0xdf, 0x17, 0xcc, 0xa7, 0x45, 0x4f, 0x53, 0x06, 0xc2, 0xfc, 0x34, 0x1c,
-		0xeb, 0x38, 0x4d, 0xf4, 0x01, 0xf6, 0xab, 0x1c, 0xec, 0x68, 0x87, 0xa9, 0x82, 0xbb, 0x84, 0xfd,
-		0x42, 0xfe, 0x33, 0xe1, 0x12, 0x70, 0x0b, 0xd6, 0x1a, 0x59, 0x35, 0x38, 0xca, 0xf6, 0xfe, 0xb4,
-		0xf6, 0xcf, 0x85, 0xd6, 0x18, 0x6f, 0x48, 0x6b, 0x1b, 0x70, 0x94, 0x23, 0xee, 0xaf, 0x5f, 0x3f,
-		0x23, 0x1c, 0x2b, 0xe3, 0xde, 0x0c, 0xf7, 0xee, 0x3b, 0xa0, 0xe0, 0xa9, 0x53, 0x84, 0xa7, 0x4e,
-		0xb5, 0xa1, 0x58, 0x7d, 0x20, 0xff, 0x1a, 0x47, 0x16, 0x1e, 0xdf, 0x8b, 0x6f, 0x9d, 0x65, 0xc5,
-		0x22, 0xe0, 0x4f, 0x41, 0x5e, 0x80, 0x37, 0x0d, 0x1b, 0xab, 0x66, 0xdd, 0xd0, 0x9e, 0xc7, 0xb5,
-		0x3e, 0xa0, 0x7f, 0xbd, 0xa5, 0xab, 0x36, 0x03, 0xec, 0x04, 0x79, 0x11, 0x72, 0x5e, 0xac, 0x52,
-		0xd5, 0x1a, 0x96, 0x69, 0xbb, 0x11, 0x88, 0x9f, 0x15, 0x3d, 0xe5, 0xf1, 0x2d, 0x52, 0xb6, 0x52,
-		0x05, 0xd8, 0xc9, 0x73, 0xbf, 0x26, 0xf9, 0x0a, 0x07, 0x1a, 0xf6, 0xb9, 0xb8, 0xe3, 0x50, 0xcd,
-		0x86, 0xa5, 0xd8, 0xfd, 0xf8, 0xbf, 0xcf, 0x09, 0xc7, 0xc1, 0x59, 0xb8, 0xe3, 0x20, 0x11, 0x1d,
-		0x99, 0xed, 0xfb, 0x40, 0xf8, 0xbc, 0x70, 0x1c, 0x82, 0x87, 0x43, 0x88, 0x80, 0xa1, 0x0f, 0x88,
-		0x7f, 0x21, 0x20, 0x04, 0x0f, 0x81, 0x78, 0xc2, 0x9f, 0x68, 0x6d, 0x5c, 0xd7, 0x1c, 0xd7, 0x66,
-		0x41, 0x71, 0x6f, 0xa8, 0xdf, 0xf8, 0x5e, 0x38, 0x08, 0x93, 0x03, 0xac, 0xc4, 0x13, 0xf1, 0x6d,
-		0x57, 0xba, 0x66, 0x8a, 0x16, 0xec, 0x37, 0x85, 0x27, 0x0a, 0xb0, 0x11, 0xd9, 0x02, 0x11, 0x22,
-		0x51, 0xbb, 0x4a, 0x56, 0x0a, 0x7d, 0xc0, 0xfd, 0x56, 0x8b, 0x70, 0xeb, 0x82, 0x97, 0x60, 0x06,
-		0xe2, 0x9f, 0xa6, 0xb1, 0x8b, 0xf7, 0xfa, 0xb2, 0xce, 0xdf, 0x6e, 0x89, 0x7f, 0x36, 0x19, 0x27,
-		0xf3, 0x21, 0xa3, 0x2d, 0xf1, 0x14, 0x8a, 0xba, 0x67, 0x94, 0x7f, 0xcf, 0xeb, 0xbc, 0xbd, 0xe1,
-		0x70, 0xaa, 0xb4, 0x44, 0x8c, 0x3c, 0x1c, 0xf4, 0x44, 0x83, 0xbd, 0xf7, 0x75, 0xcf, 0xce, 0x43,
-		0x31, 0x4f, 0xe9, 0x12, 0x0c, 0x87, 0x02, 0x9e, 0x68, 0xa8, 0xbf, 0xc1, 0xa1, 0xb2, 0xc1, 0x78,
-		0xa7, 0x74, 0x06, 0x12, 0x24, 0x78, 0x89, 0x66, 0xff, 0x9b, 0x9c, 0x9d, 0x92, 0x97, 0xde, 0x0a,
-		0x69, 0x11, 0xb4, 0x44, 0xb3, 0xfe, 0x1c, 0x67, 0xf5, 0x58, 0x08, 0xbb, 0x08, 0x58, 0xa2, 0xd9,
-		0xff, 0x96, 0x60, 0x17, 0x2c, 0x84, 0xbd, 0x7f, 0x15, 0x7e, 0xe1, 0x6f, 0x27, 0xf8, 0xa4, 0x23,
-		0x74, 0x77, 0x11, 0x06, 0x79, 0xa4, 0x12, 0xcd, 0xfd, 0x3e, 0x5e, 0xb9, 0xe0, 0x28, 0x9d, 0x83,
-		0x64, 0x9f, 0x0a, 0xff, 0x3b, 0x9c, 0x95, 0xd1, 0x97, 0xe6, 0x60, 0x28, 0x10, 0x9d, 0x44, 0xb3,
-		0xff, 0x5d, 0xce, 0x1e, 0xe4, 0x22, 0xa2, 0xf3, 0xe8, 0x24, 0x1a, 0xe0, 0xef, 0x09, 0xd1, 0x39,
-		0x07, 0x51, 0x9b, 0x08, 0x4c, 0xa2, 0xb9, 0xdf, 0x2f, 0xb4, 0x2e, 0x58, 0x4a, 0x8f, 0x43, 0xc6,
-		0x9b, 0x6c, 0xa2, 0xf9, 0x7f, 0x9e, 0xf3, 0xfb, 0x3c, 0x44, 0x03, 0x81, 0xc9, 0x2e, 0x1a, 0xe2,
-		0xef, 0x0b, 0x0d, 0x04, 0xb8, 0xc8, 0x30, 0x6a, 0x0d, 0x60, 0xa2, 0x91, 0x3e, 0x20, 0x86, 0x51,
-		0x4b, 0xfc, 0x42, 0x7a, 0x93, 0xfa, 0xfc, 0x68, 0x88, 0x7f, 0x20, 0x7a, 0x93, 0xd2, 0x13, 0x31,
-		0x5a, 0x23, 0x82, 0x68, 0x8c, 0x5f, 0x10, 0x62, 0xb4, 0x04, 0x04, 0xa5, 0x35, 0x40, 0xed, 0xd1,
-		0x40, 0x34, 0xde, 0x07, 0x39, 0xde, 0x58, 0x5b, 0x30, 0x50, 0x7a, 0x12, 0x8e, 0x76, 0x8e, 0x04,
-		0xa2, 0x51, 0x3f, 0xf4, 0x7a, 0xcb, 0xda, 0x2d, 0x18, 0x08, 0x94, 0x36, 0xfc, 0x29, 0x25, 0x18,
-		0x05, 0x44, 0xc3, 0x7e, 0xf8, 0xf5, 0xb0, 0xe3, 0x0e, 0x06, 0x01, 0xa5, 0x59, 0x00, 0x7f, 0x02,
-		0x8e, 0xc6, 0xfa, 0x08, 0xc7, 0x0a, 0x30, 0x91, 0xa1, 0xc1, 0xe7, 0xdf, 0x68, 0xfe, 0x9b, 0x62,
-		0x68, 0x70, 0x0e, 0x32, 0x34, 0xc4, 0xd4, 0x1b, 0xcd, 0xfd, 0x51, 0x31, 0x34, 0x04, 0x0b, 0xb1,
-		0xec, 0xc0, 0xec, 0x16, 0x8d, 0xf0, 0x31, 0x61, 0xd9, 0x01, 0xae, 0xd2, 0x0a, 0x8c, 0xb5, 0x4d,
-		0x88, 0xd1, 0x50, 0xbf, 0xc4, 0xa1, 0x72, 0xad, 0xf3, 0x61, 0x70, 0xf2, 0xe2, 0x93, 0x61, 0x34,
-		0xda, 0xc7, 0x5b, 0x26, 0x2f, 0x3e, 0x17, 0x96, 0x2e, 0x42, 0xda, 0x68, 0xea, 0x3a, 0x19, 0x3c,
-		0xa8, 0xf7, 0xdd, 0xc0, 0xfc, 0x7f, 0xfe, 0x21, 0xd7, 0x8e, 0x60, 0x28, 0x9d, 0x81, 0x24, 0x6e,
-		0x6c, 0xe1, 0x5a, 0x14, 0xe7, 0x77, 0x7f, 0x28, 0x1c, 0x26, 0xa1, 0x2e, 0x3d, 0x0e, 0xc0, 0xb6,
-		0x46, 0xe8, 0x61, 0x60, 0x04, 0xef, 0x7f, 0xf9, 0x21, 0xbf, 0x8c, 0xe3, 0xb3, 0xf8, 0x00, 0xec,
-		0x6a, 0x4f, 0x6f, 0x80, 0xef, 0x85, 0x01, 0x68, 0x8f, 0x5c, 0x80, 0xc1, 0x67, 0x1d, 0xd3, 0x70,
-		0x95, 0x7a, 0x14, 0xf7, 0x7f, 0xe5, 0xdc, 0x82, 0x9e, 0x28, 0xac, 0x61, 0xda, 0xd8, 0x55, 0xea,
-		0x4e, 0x14, 0xef, 0x7f, 0xe3, 0xbc, 0x1e, 0x03, 0x61, 0x56, 0x15, 0xc7, 0xed, 0xa7, 0xdd, 0x7f,
-		0x21, 0x98, 0x05, 0x03, 0x11, 0x9a, 0xfc, 0xde, 0xc5, 0x7b, 0x51, 0xbc, 0xdf, 0x17, 0x42, 0x73,
-		0xfa, 0xd2, 0x5b, 0x21, 0x43, 0x7e, 0xb2, 0x1b, 0x76, 0x11, 0xcc, 0xff, 0x9d, 0x33, 0xfb, 0x1c,
-		0xa4, 0x66, 0xc7, 0xad, 0xb9, 0x5a, 0xb4, 0xb2, 0x7f, 0xc0, 0x7b, 0x5a, 0xd0, 0x97, 0x66, 0x61,
-		0xc8, 0x71, 0x6b, 0xb5, 0x26, 0x8f, 0x4f, 0x23, 0xd8, 0xff, 0xc7, 0x0f, 0xbd, 0x2d, 0x0b, 0x8f,
-		0x87, 0xf4, 0xf6, 0x73, 0xbb, 0xae, 0x65, 0xd2, 0x03, 0x8f, 0x28, 0x84, 0xd7, 0x39, 0x42, 0x80,
-		0xa5, 0x34, 0x07, 0x59, 0xd2, 0x16, 0x1b, 0x5b, 0x98, 0x9e, 0x4e, 0x45, 0x40, 0xfc, 0x4f, 0xae,
-		0x80, 0x10, 0x53, 0xf9, 0xa7, 0xbf, 0xf4, 0xda, 0xa4, 0xf4, 0xd5, 0xd7, 0x26, 0xa5, 0x6f, 0xbe,
-		0x36, 0x29, 0xbd, 0xff, 0x5b, 0x93, 0x03, 0x5f, 0xfd, 0xd6, 0xe4, 0xc0, 0x1f, 0x7d, 0x6b, 0x72,
-		0xa0, 0xf3, 0x2e, 0x31, 0x2c, 0x98, 0x0b, 0x26, 0xdb, 0x1f, 0x7e, 0xa6, 0x58, 0xd7, 0xdc, 0x9d,
-		0xe6, 0xd6, 0x8c, 0x6a, 0x36, 0xe8, 0x36, 0xae, 0xbf, 0x5b, 0xeb, 0x2d, 0x72, 0xe0, 0xbb, 0x31,
-		0x38, 0xa6, 0x9a, 0x4e, 0xc3, 0x74, 0xaa, 0x6c, 0xbf, 0x97, 0x25, 0xf8, 0x8e, 0x6f, 0x36, 0x58,
-		0xd4, 0xc7, 0xa6, 0xef, 0x06, 0x4c, 0x68, 0x0d, 0x4b, 0xc7, 0x74, 0x73, 0xbe, 0x4a, 0xb5, 0xd0,
-		0x5f, 0x30, 0xf8, 0xe5, 0xff, 0x90, 0x64, 0x9b, 0x90, 0x3e, 0xfb, 0xa2, 0xe0, 0x2e, 0x2d, 0xc1,
-		0x98, 0xa2, 0xaa, 0xd8, 0x0a, 0x41, 0x46, 0x28, 0x53, 0x00, 0xe6, 0x38, 0xa7, 0x8f, 0x76, 0x0e,
-		0x52, 0x8e, 0xaa, 0xe8, 0x4a, 0x64, 0x97, 0x7e, 0x85, 0x43, 0x70, 0xf2, 0xf2, 0xf9, 0x6e, 0x3d,
-		0xf1, 0xcc, 0x64, 0x40, 0xd1, 0x4c, 0x63, 0xfc, 0xcf, 0x43, 0x0c, 0x39, 0xc5, 0xee, 0x2e, 0xc3,
-		0x1f, 0xc6, 0x61, 0x92, 0x97, 0x6f, 0x29, 0x0e, 0x3e, 0x75, 0xfd, 0x91, 0x2d, 0xec, 0x2a, 0x8f,
-		0x9c, 0x52, 0x4d, 0xcd, 0xe0, 0x1a, 0x1f, 0xe7, 0xfa, 0x27, 0xe5, 0x33, 0xbc, 0xbc, 0xd0, 0x71,
-		0x3b, 0xbe, 0xd0, 0xbd, 0xdf, 0x8a, 0x9b, 0x90, 0x98, 0x33, 0x35, 0x03, 0x4d, 0x40, 0xb2, 0x86,
-		0x0d, 0xb3, 0xc1, 0x2f, 0xe2, 0xb1, 0x04, 0x7a, 0x04, 0x52, 0x4a, 0xc3, 0x6c, 0x1a, 0x2e, 0x3b,
-		0xa4, 0x28, 0x1f, 0xfb, 0xd2, 0xab, 0x53, 0x03, 0x7f, 0xfc, 0xea, 0x54, 0x7c, 0xd1, 0x70, 0xff,
-		0xe0, 0x95, 0x87, 0x80, 0x43, 0x2d, 0x1a, 0xae, 0xcc, 0x09, 0x4b, 0x89, 0xef, 0xbc, 0x3c, 0x25,
-		0x15, 0x9f, 0x82, 0xc1, 0x79, 0xac, 0x1e, 0x04, 0x79, 0x1e, 0xab, 0x01, 0xe4, 0x79, 0xac, 0xb6,
-		0x20, 0x9f, 0x83, 0xf4, 0xa2, 0xe1, 0xb2, 0x6b, 0x94, 0x0f, 0x40, 0x5c, 0x33, 0xd8, 0xcd, 0x9c,
-		0x9e, 0xb2, 0x11, 0x2a, 0xc2, 0x38, 0x8f, 0x55, 0x8f, 0xb1, 0x86, 0xd5, 0x56, 0xc6, 0xf6, 0xaa,
-		0x09, 0x55, 0x79, 0xfe, 0x8f, 0xfe, 0xd3, 0xe4, 0xc0, 0x0b, 0xaf, 0x4d, 0x0e, 0x74, 0xed, 0xd5,
-		0x62, 0xd7, 0x5e, 0x75, 0x6a, 0xbb, 0xec, 0x78, 0xc5, 0xeb, 0xd9, 0x3f, 0x4f, 0x41, 0x91, 0xd3,
-		0x38, 0xae, 0xb2, 0xab, 0x19, 0x75, 0xaf, 0x73, 0x95, 0xa6, 0xbb, 0xf3, 0x3c, 0xef, 0xdd, 0xa3,
-		0x5c, 0x0a, 0x4e, 0x73, 0xe0, 0x0e, 0x2e, 0x44, 0x98, 0x51, 0xf1, 0xcf, 0xe2, 0x80, 0xd6, 0x5d,
-		0x65, 0x17, 0xcf, 0x36, 0xdd, 0x1d, 0xd3, 0xd6, 0x9e, 0x67, 0x6e, 0x10, 0x03, 0x34, 0x94, 0x1b,
-		0x55, 0xd7, 0xdc, 0xc5, 0x86, 0x43, 0x15, 0x35, 0x74, 0xfa, 0xd8, 0x4c, 0x07, 0x93, 0x9b, 0x21,
-		0x9d, 0x5c, 0x7e, 0xe0, 0x53, 0xdf, 0x98, 0x3a, 0x1e, 0xad, 0x05, 0x4a, 0x4c, 0xe2, 0xf2, 0x1b,
-		0x1b, 0x14, 0x18, 0x5d, 0x03, 0x76, 0x3f, 0xa3, 0xaa, 0x6b, 0x8e, 0xcb, 0x2f, 0x7d, 0x9f, 0x99,
-		0xe9, 0xdc, 0xf6, 0x99, 0x76, 0x31, 0x67, 0xae, 0x29, 0xba, 0x56, 0x53, 0x5c, 0xd3, 0x76, 0x2e,
-		0x0f, 0xc8, 0x19, 0x0a, 0xb5, 0xa4, 0x39, 0x2e, 0xda, 0x80, 0x4c, 0x0d, 0x1b, 0x7b, 0x0c, 0x36,
-		0xfe, 0xc6, 0x60, 0xd3, 0x04, 0x89, 0xa2, 0x3e, 0x05, 0x48, 0x09, 0xd2, 0x89, 0x57, 0x4e, 0xec,
-		0xb2, 0x66, 0x17, 0xf8, 0x10, 0x32, 0x7d, 0x94, 0x31, 0xa6, 0xb4, 0x66, 0x15, 0xde, 0x0e, 0xe0,
-		0xd7, 0x89, 0x4e, 0xc3, 0xa0, 0x52, 0xab, 0xd9, 0xd8, 0x71, 0xe8, 0xd9, 0x61, 0xa6, 0x9c, 0xff,
-		0x83, 0x57, 0x1e, 0x9a, 0xe0, 0xf8, 0xb3, 0xac, 0x84, 0x2d, 0xc7, 0x65, 0x41, 0x58, 0x1a, 0xfb,
-		0xca, 0x2b, 0x0f, 0x0d, 0x87, 0xea, 0x2a, 0x67, 0x01, 0xae, 0x7b, 0xa0, 0x27, 0x3f, 0x2a, 0xc1,
-		0x58, 0x9b, 0x2c, 0xa8, 0x08, 0x93, 0xb3, 0x9b, 0x1b, 0x97, 0x57, 0xe5, 0xc5, 0x67, 0x66, 0x37,
-		0x16, 0x57, 0x57, 0xaa, 0xec, 0x65, 0xc1, 0xca, 0xfa, 0x5a, 0x65, 0x6e, 0xf1, 0xd2, 0x62, 0x65,
-		0x3e, 0x37, 0x80, 0xa6, 0xe0, 0xf6, 0x0e, 0x34, 0xf3, 0x95, 0xa5, 0xca, 0xc2, 0xec, 0x46, 0x25,
-		0x27, 0xa1, 0xbb, 0xe0, 0xce, 0x8e, 0x20, 0x1e, 0x49, 0xac, 0x0b, 0x89, 0x5c, 0xf1, 0x48, 0xe2,
-		0xe5, 0x4b, 0x5d, 0xc7, 0xd7, 0x83, 0x3d, 0x2d, 0xeb, 0x86, 0x37, 0x90, 0xc2, 0x23, 0xed, 0x3d,
-		0x31, 0x38, 0xd6, 0x3a, 0x0f, 0x29, 0xc6, 0x5e, 0x97, 0xc7, 0xa5, 0x9d, 0x47, 0x56, 0xf1, 0x32,
-		0xc4, 0x67, 0x8d, 0x3d, 0x74, 0x8c, 0x05, 0xe9, 0xd5, 0xa6, 0xad, 0x73, 0x3f, 0x36, 0x48, 0xd2,
-		0x9b, 0xb6, 0x4e, 0xfc, 0x9b, 0x78, 0x4f, 0x20, 0x9d, 0xc8, 0xf2, 0x47, 0x02, 0xa5, 0xdc, 0x07,
-		0x5f, 0x9e, 0x1a, 0xf8, 0xcc, 0xcb, 0x53, 0x03, 0xdf, 0xff, 0xd8, 0xd4, 0xc0, 0x0b, 0x7f, 0x32,
-		0x3d, 0x50, 0xde, 0x6d, 0x6d, 0xde, 0x17, 0x22, 0xa7, 0xe8, 0xf4, 0xac, 0xb1, 0x47, 0x1d, 0xd6,
-		0x9a, 0xf4, 0x4c, 0x92, 0x36, 0x4e, 0x9c, 0xca, 0x4e, 0xb6, 0x9e, 0xca, 0x3e, 0x89, 0x75, 0xfd,
-		0xaa, 0x61, 0x3e, 0x47, 0x7b, 0xd5, 0xd7, 0xc1, 0x07, 0x62, 0x30, 0xd9, 0x36, 0x17, 0xf3, 0xb0,
-		0xa5, 0xdb, 0x2b, 0xdb, 0x12, 0xa4, 0xe7, 0x45, 0x34, 0x94, 0x87, 0x41, 0x07, 0xab, 0xa6, 0x51,
-		0x63, 0x3e, 0x20, 0x2e, 0x8b, 0x24, 0x69, 0xb6, 0xa1, 0x18, 0xa6, 0xc3, 0xaf, 0xf6, 0xb3, 0x44,
-		0xf9, 0x17, 0xa5, 0xfd, 0x05, 0x21, 0xc3, 0xa2, 0x26, 0xd1, 0xcc, 0x47, 0x22, 0xcf, 0xa9, 0x77,
-		0x49, 0x2b, 0xbd, 0x46, 0x84, 0xce, 0xaa, 0xfb, 0xd5, 0xca, 0x2f, 0xc4, 0x60, 0xaa, 0x55, 0x2b,
-		0x24, 0x16, 0x74, 0x5c, 0xa5, 0x61, 0x75, 0x53, 0xcb, 0x45, 0xc8, 0x6c, 0x08, 0x9a, 0x7d, 0xeb,
-		0xe5, 0xe6, 0x3e, 0xf5, 0x32, 0xe2, 0x55, 0x25, 0x14, 0x73, 0xba, 0x4f, 0xc5, 0x78, 0xed, 0x38,
-		0x90, 0x66, 0x3e, 0x95, 0x80, 0x3b, 0xe9, 0xdb, 0x2f, 0xbb, 0xa1, 0x19, 0xee, 0x29, 0xd5, 0xde,
-		0xb3, 0x5c, 0x1a, 0x0d, 0x9a, 0xdb, 0x5c, 0x2f, 0x63, 0x7e, 0xf1, 0x0c, 0x2b, 0xee, 0x32, 0x72,
-		0xb6, 0x21, 0xb9, 0x46, 0xf8, 0x88, 0x46, 0x5c, 0xd3, 0x55, 0x74, 0xae, 0x29, 0x96, 0x20, 0xb9,
-		0xec, 0xbd, 0x58, 0x8c, 0xe5, 0x6a, 0xe2, 0xa9, 0x98, 0x8e, 0x95, 0x6d, 0x76, 0xed, 0x3e, 0x4e,
-		0x07, 0x54, 0x9a, 0x64, 0xd0, 0x1b, 0xf6, 0x13, 0x90, 0x54, 0x9a, 0xec, 0x7e, 0x48, 0x9c, 0x8c,
-		0x34, 0x9a, 0x28, 0x5e, 0x85, 0x41, 0x7e, 0x4a, 0x8d, 0x72, 0x10, 0xdf, 0xc5, 0x7b, 0xb4, 0x9e,
-		0xac, 0x4c, 0x7e, 0xa2, 0x19, 0x48, 0x52, 0xe1, 0xf9, 0xd4, 0x92, 0x9f, 0x69, 0x93, 0x7e, 0x86,
-		0x0a, 0x29, 0x33, 0xb2, 0xe2, 0x15, 0x48, 0xcf, 0x9b, 0x0d, 0xcd, 0x30, 0xc3, 0x68, 0x19, 0x86,
-		0x46, 0x65, 0xb6, 0x9a, 0x3c, 0x66, 0x91, 0x59, 0x02, 0x1d, 0x85, 0x14, 0x7b, 0x86, 0xc1, 0xef,
-		0xb8, 0xf0, 0x54, 0x71, 0x0e, 0x06, 0x29, 0xf6, 0xaa, 0x85, 0x10, 0x7f, 0xc0, 0xc7, 0xdf, 0x7b,
-		0xd0, 0xd5, 0x15, 0x87, 0x8f, 0xf9, 0xc2, 0x22, 0x48, 0xd4, 0x14, 0x57, 0xe1, 0xed, 0xa6, 0xbf,
-		0x8b, 0x6f, 0x83, 0x34, 0x07, 0x21, 0xd3, 0x42, 0xdc, 0xb4, 0x1c, 0x7e, 0x4b, 0xa5, 0xd0, 0xad,
-		0x29, 0xab, 0x56, 0x39, 0x41, 0x22, 0x1a, 0x99, 0x10, 0x97, 0xe5, 0xae, 0x4e, 0xf5, 0x7c, 0xc0,
-		0xa9, 0x06, 0xba, 0x3c, 0xf0, 0x93, 0x75, 0x69, 0x9b, 0x39, 0x78, 0xc6, 0xf2, 0xb1, 0x18, 0x4c,
-		0x06, 0x4a, 0xaf, 0x63, 0xdb, 0xd1, 0x4c, 0x83, 0xcf, 0xf4, 0xcc, 0x5a, 0x50, 0x40, 0x48, 0x5e,
-		0xde, 0xc5, 0x5c, 0xde, 0x0a, 0xf1, 0x59, 0xcb, 0x42, 0x05, 0x48, 0xd3, 0xb4, 0x6a, 0x32, 0x7b,
-		0x49, 0xc8, 0x5e, 0x9a, 0x94, 0x39, 0xe6, 0xb6, 0xfb, 0x9c, 0x62, 0x7b, 0x2f, 0x15, 0x45, 0xba,
-		0x78, 0x01, 0x32, 0x73, 0xa6, 0xe1, 0x60, 0xc3, 0x69, 0xd2, 0x31, 0xb8, 0xa5, 0x9b, 0xea, 0x2e,
-		0x47, 0x60, 0x09, 0xa2, 0x70, 0xc5, 0xb2, 0x28, 0x67, 0x42, 0x26, 0x3f, 0x59, 0x44, 0x59, 0x5e,
-		0xef, 0xaa, 0xa2, 0x0b, 0xfb, 0x57, 0x11, 0x6f, 0xa4, 0xa7, 0xa3, 0x1f, 0x49, 0x70, 0x47, 0xfb,
-		0x80, 0xda, 0xc5, 0x7b, 0xce, 0x7e, 0xc7, 0xd3, 0x53, 0x90, 0x59, 0xa3, 0x9f, 0x0b, 0xb8, 0x8a,
-		0xf7, 0x50, 0x01, 0x06, 0x71, 0xed, 0xf4, 0x99, 0x33, 0x8f, 0x5c, 0x60, 0xd6, 0x7e, 0x79, 0x40,
-		0x16, 0x19, 0x68, 0x12, 0x32, 0x0e, 0x56, 0xad, 0xd3, 0x67, 0xce, 0xee, 0x3e, 0xc2, 0xcc, 0x8b,
-		0xc4, 0x46, 0x5e, 0x56, 0x29, 0x4d, 0x5a, 0xfd, 0x9d, 0x8f, 0x4d, 0x49, 0xe5, 0x24, 0xc4, 0x9d,
-		0x66, 0xe3, 0x96, 0xda, 0xc8, 0x87, 0x93, 0x30, 0x1d, 0xe4, 0xa4, 0x9e, 0xca, 0x8b, 0x4a, 0xb8,
-		0x0e, 0x72, 0x01, 0x1d, 0x50, 0x8a, 0x2e, 0x61, 0x6e, 0x4f, 0x4d, 0x16, 0x7f, 0x5d, 0x82, 0xac,
-		0x17, 0x44, 0xad, 0x63, 0x17, 0x5d, 0x0c, 0xc6, 0x3f, 0x7c, 0xd8, 0xdc, 0x3e, 0xd3, 0x5a, 0x97,
-		0x1f, 0xec, 0xc9, 0x01, 0x72, 0x74, 0x8e, 0x1a, 0xa2, 0x65, 0x3a, 0xfc, 0xf5, 0x5a, 0x04, 0xab,
-		0x47, 0x8c, 0x1e, 0x04, 0x44, 0x3d, 0x5c, 0xf5, 0xba, 0xe9, 0x6a, 0x46, 0xbd, 0x6a, 0x99, 0xcf,
-		0xf1, 0x37, 0xc1, 0x71, 0x39, 0x47, 0x4b, 0xae, 0xd1, 0x82, 0x35, 0x92, 0x4f, 0x84, 0xce, 0x78,
-		0x28, 0x64, 0x5a, 0xf1, 0x03, 0x3f, 0xe2, 0x04, 0x44, 0x12, 0x5d, 0x84, 0x41, 0xab, 0xb9, 0x55,
-		0x15, 0x1e, 0x63, 0xe8, 0xf4, 0x1d, 0x9d, 0xc6, 0xbf, 0xb0, 0x0f, 0xee, 0x01, 0x52, 0x56, 0x73,
-		0x8b, 0x58, 0xcb, 0x5d, 0x90, 0xed, 0x20, 0xcc, 0xd0, 0x75, 0x5f, 0x0e, 0xfa, 0x95, 0x0a, 0xde,
-		0x82, 0xaa, 0x65, 0x6b, 0xa6, 0xad, 0xb9, 0x7b, 0x34, 0xb2, 0x8d, 0xcb, 0x39, 0x51, 0xb0, 0xc6,
-		0xf3, 0x8b, 0xbb, 0x30, 0xba, 0x4e, 0x97, 0xdf, 0xbe, 0xe4, 0x67, 0x7c, 0xf9, 0xa4, 0x68, 0xf9,
-		0xba, 0x4a, 0x16, 0x6b, 0x93, 0xac, 0xfc, 0x44, 0x57, 0xeb, 0x3c, 0xb7, 0x7f, 0xeb, 0x0c, 0x47,
-		0x88, 0x7f, 0x71, 0x2c, 0x34, 0x38, 0x99, 0x71, 0x06, 0xdd, 0x57, 0xbf, 0x86, 0x19, 0x15, 0x4d,
-		0x14, 0x7a, 0x4f, 0xaa, 0x85, 0x08, 0x37, 0x5a, 0x88, 0x1c, 0x42, 0xc5, 0x0b, 0x30, 0xbc, 0xa6,
-		0xd8, 0xee, 0x3a, 0x76, 0x2f, 0x63, 0xa5, 0x86, 0xed, 0xf0, 0xac, 0x3b, 0x2c, 0x66, 0x5d, 0x04,
-		0x09, 0x3a, 0xb5, 0xb2, 0x59, 0x87, 0xfe, 0x2e, 0xee, 0x40, 0x82, 0x5e, 0x37, 0xf5, 0x66, 0x64,
-		0xce, 0xc1, 0x66, 0x64, 0xe2, 0x4b, 0xf7, 0x5c, 0xec, 0x88, 0xf0, 0x96, 0x26, 0xd0, 0x63, 0x62,
-		0x5e, 0x8d, 0xf7, 0x9e, 0x57, 0xb9, 0x21, 0xf2, 0xd9, 0x55, 0x87, 0xc1, 0x32, 0x71, 0xc5, 0x8b,
-		0xf3, 0x9e, 0x20, 0x92, 0x2f, 0x08, 0x5a, 0x86, 0x51, 0x4b, 0xb1, 0x5d, 0xfa, 0xf2, 0x66, 0x87,
-		0xb6, 0x82, 0xdb, 0xfa, 0x54, 0xfb, 0xc8, 0x0b, 0x35, 0x96, 0xd7, 0x32, 0x6c, 0x05, 0x33, 0x8b,
-		0x7f, 0x96, 0x80, 0x14, 0x57, 0xc6, 0x5b, 0x61, 0x90, 0xab, 0x95, 0x5b, 0xe7, 0x9d, 0x33, 0xed,
-		0x13, 0xd3, 0x8c, 0x37, 0x81, 0x70, 0x3c, 0xc1, 0x83, 0xee, 0x83, 0xb4, 0xba, 0xa3, 0x68, 0x46,
-		0x55, 0xab, 0xf1, 0xed, 0x8a, 0xa1, 0xd7, 0x5e, 0x9d, 0x1a, 0x9c, 0x23, 0x79, 0x8b, 0xf3, 0xf2,
-		0x20, 0x2d, 0x5c, 0xac, 0x91, 0x48, 0x60, 0x07, 0x6b, 0xf5, 0x1d, 0x97, 0x8f, 0x30, 0x9e, 0x42,
-		0xe7, 0x21, 0x41, 0x0c, 0x82, 0xbf, 0xcb, 0x2c, 0xb4, 0x6d, 0x26, 0x79, 0xc1, 0x5e, 0x39, 0x4d,
-		0x2a, 0x7e, 0xff, 0x37, 0xa6, 0x24, 0x99, 0x72, 0xa0, 0x39, 0x18, 0xd6, 0x15, 0xc7, 0xad, 0xd2,
-		0x19, 0x8c, 0x54, 0x9f, 0xe4, 0x2b, 0xf1, 0x36, 0x85, 0x70, 0xc5, 0x72, 0xd1, 0x87, 0x08, 0x17,
-		0xcb, 0xaa, 0xa1, 0x13, 0x90, 0xa3, 0x20, 0xaa, 0xd9, 0x68, 0x68, 0x2e, 0x8b, 0xad, 0x52, 0x54,
-		0xef, 0x23, 0x24, 0x7f, 0x8e, 0x66, 0xd3, 0x08, 0xeb, 0x76, 0xc8, 0xd0, 0x97, 0x60, 0x94, 0x84,
-		0xdd, 0x71, 0x4e, 0x93, 0x0c, 0x5a, 0x78, 0x1c, 0x46, 0x7d, 0xff, 0xc8, 0x48, 0xd2, 0x0c, 0xc5,
-		0xcf, 0xa6, 0x84, 0x0f, 0xc3, 0x84, 0x81, 0x6f, 0xd0, 0x5b, 0xd7, 0x21, 0xea, 0x0c, 0xa5, 0x46,
-		0xa4, 0xec, 0x5a, 0x98, 0xe3, 0x5e, 0x18, 0x51, 0x85, 0xf2, 0x19, 0x2d, 0x50, 0xda, 0x61, 0x2f,
-		0x97, 0x92, 0x1d, 0x83, 0xb4, 0x62, 0x59, 0x8c, 0x60, 0x88, 0xfb, 0x47, 0xcb, 0xa2, 0x45, 0x27,
-		0x61, 0x8c, 0xb6, 0xd1, 0xc6, 0x4e, 0x53, 0x77, 0x39, 0x48, 0x96, 0xd2, 0x8c, 0x92, 0x02, 0x99,
-		0xe5, 0x53, 0xda, 0xbb, 0x61, 0x18, 0x5f, 0xd7, 0x6a, 0xd8, 0x50, 0x31, 0xa3, 0x1b, 0xa6, 0x74,
-		0x59, 0x91, 0x49, 0x89, 0xee, 0x07, 0xcf, 0xef, 0x55, 0x85, 0x4f, 0x1e, 0x61, 0x78, 0x22, 0x9f,
-		0xaf, 0xc4, 0x8b, 0x79, 0x48, 0xcc, 0x2b, 0xae, 0x42, 0x02, 0x0c, 0xf7, 0x06, 0x9b, 0x68, 0xb2,
-		0x32, 0xf9, 0x59, 0xfc, 0x4e, 0x0c, 0x12, 0xd7, 0x4c, 0x17, 0xa3, 0x47, 0x03, 0x01, 0xe0, 0x48,
-		0x27, 0x7b, 0x5e, 0xd7, 0xea, 0x06, 0xae, 0x2d, 0x3b, 0xf5, 0xc0, 0x67, 0x1b, 0x7c, 0x73, 0x8a,
-		0x85, 0xcc, 0x69, 0x02, 0x92, 0xb6, 0xd9, 0x34, 0x6a, 0xe2, 0x7a, 0x30, 0x4d, 0xa0, 0x0a, 0xa4,
-		0x3d, 0x2b, 0x49, 0x44, 0x59, 0xc9, 0x28, 0xb1, 0x12, 0x62, 0xc3, 0x3c, 0x43, 0x1e, 0xdc, 0xe2,
-		0xc6, 0x52, 0x86, 0x8c, 0xe7, 0xbc, 0xb8, 0xb5, 0xf5, 0x67, 0xb0, 0x3e, 0x1b, 0x99, 0x4c, 0xbc,
-		0xbe, 0xf7, 0x94, 0xc7, 0x2c, 0x2e, 0xe7, 0x15, 0x70, 0xed, 0x85, 0xcc, 0x8a, 0x7f, 0x42, 0x62,
-		0x90, 0xb6, 0xcb, 0x37, 0x2b, 0xf6, 0x19, 0x89, 0x3b, 0x20, 0xe3, 0x68, 0x75, 0x43, 0x71, 0x9b,
-		0x36, 0xe6, 0x96, 0xe7, 0x67, 0x14, 0xbf, 0x20, 0x41, 0x8a, 0x59, 0x72, 0x40, 0x6f, 0x52, 0x67,
-		0xbd, 0xc5, 0xba, 0xe9, 0x2d, 0x7e, 0x70, 0xbd, 0xcd, 0x02, 0x78, 0xc2, 0x38, 0xfc, 0x65, 0x7f,
-		0x87, 0x88, 0x81, 0x89, 0xb8, 0xae, 0xd5, 0xf9, 0x40, 0x0d, 0x30, 0x15, 0xff, 0x54, 0x22, 0x41,
-		0x2c, 0x2f, 0x47, 0xb3, 0x30, 0x2c, 0xe4, 0xaa, 0x6e, 0xeb, 0x4a, 0x9d, 0xdb, 0xce, 0x9d, 0x5d,
-		0x85, 0xbb, 0xa4, 0x2b, 0x75, 0x79, 0x88, 0xcb, 0x43, 0x12, 0x9d, 0xfb, 0x21, 0xd6, 0xa5, 0x1f,
-		0x42, 0x1d, 0x1f, 0x3f, 0x58, 0xc7, 0x87, 0xba, 0x28, 0xd1, 0xda, 0x45, 0x9f, 0x8d, 0xd1, 0xc5,
-		0x8c, 0x65, 0x3a, 0x8a, 0xfe, 0xe3, 0x18, 0x11, 0xb7, 0x43, 0xc6, 0x32, 0xf5, 0x2a, 0x2b, 0x61,
-		0xd7, 0xe6, 0xd3, 0x96, 0xa9, 0xcb, 0x6d, 0xdd, 0x9e, 0x3c, 0xa4, 0xe1, 0x92, 0x3a, 0x04, 0xad,
-		0x0d, 0xb6, 0x6a, 0xcd, 0x86, 0x2c, 0x53, 0x05, 0x9f, 0xcb, 0x1e, 0x26, 0x3a, 0xa0, 0x93, 0xa3,
-		0xd4, 0x3e, 0xf7, 0x32, 0xb1, 0x19, 0xa5, 0xcc, 0xe9, 0x08, 0x07, 0x73, 0xfd, 0x9d, 0x56, 0xc1,
-		0x41, 0xb3, 0x94, 0x39, 0x5d, 0xf1, 0x1f, 0x4a, 0x00, 0x4b, 0x44, 0xb3, 0xb4, 0xbd, 0x64, 0x16,
-		0x72, 0xa8, 0x08, 0xd5, 0x50, 0xcd, 0x93, 0xdd, 0x3a, 0x8d, 0xd7, 0x9f, 0x75, 0x82, 0x72, 0xcf,
-		0xc1, 0xb0, 0x6f, 0x8c, 0x0e, 0x16, 0xc2, 0x4c, 0xf6, 0x88, 0xaa, 0xd7, 0xb1, 0x2b, 0x67, 0xaf,
-		0x07, 0x52, 0xc5, 0xdf, 0x95, 0x20, 0x43, 0x65, 0x5a, 0xc6, 0xae, 0x12, 0xea, 0x43, 0xe9, 0xe0,
-		0x7d, 0x78, 0x27, 0x00, 0x83, 0x71, 0xb4, 0xe7, 0x31, 0xb7, 0xac, 0x0c, 0xcd, 0x59, 0xd7, 0x9e,
-		0xc7, 0xe8, 0xac, 0xa7, 0xf0, 0x78, 0x6f, 0x85, 0x8b, 0xa8, 0x9b, 0xab, 0xfd, 0x36, 0x18, 0xa4,
-		0x5f, 0xc2, 0xba, 0xe1, 0xf0, 0x40, 0x3a, 0x65, 0x34, 0x1b, 0x1b, 0x37, 0x9c, 0xe2, 0xb3, 0x30,
-		0xb8, 0x71, 0x83, 0xed, 0x8d, 0xdc, 0x0e, 0x19, 0xdb, 0x34, 0xf9, 0x9c, 0xcc, 0x62, 0xa1, 0x34,
-		0xc9, 0xa0, 0x53, 0x90, 0xd8, 0x0f, 0x88, 0xf9, 0xfb, 0x01, 0xfe, 0x86, 0x46, 0xbc, 0xaf, 0x0d,
-		0x8d, 0x93, 0x7f, 0x28, 0xc1, 0x50, 0xc0, 0x3f, 0xa0, 0x47, 0xe0, 0x48, 0x79, 0x69, 0x75, 0xee,
-		0x6a, 0x75, 0x71, 0xbe, 0x7a, 0x69, 0x69, 0x76, 0xc1, 0x7f, 0x18, 0x56, 0x38, 0xfa, 0xd2, 0xcd,
-		0x69, 0x14, 0xa0, 0xdd, 0x34, 0xe8, 0x8e, 0x12, 0x3a, 0x05, 0x13, 0x61, 0x96, 0xd9, 0xf2, 0x7a,
-		0x65, 0x65, 0x23, 0x27, 0x15, 0x8e, 0xbc, 0x74, 0x73, 0x7a, 0x2c, 0xc0, 0x31, 0xbb, 0xe5, 0x60,
-		0xc3, 0x6d, 0x67, 0x98, 0x5b, 0x5d, 0x5e, 0x5e, 0xdc, 0xc8, 0xc5, 0xda, 0x18, 0xb8, 0xc3, 0xbe,
-		0x1f, 0xc6, 0xc2, 0x0c, 0x2b, 0x8b, 0x4b, 0xb9, 0x78, 0x01, 0xbd, 0x74, 0x73, 0x7a, 0x24, 0x40,
-		0xbd, 0xa2, 0xe9, 0x85, 0xf4, 0x8b, 0x1f, 0x9f, 0x1c, 0xf8, 0xe5, 0x4f, 0x4c, 0x4a, 0xa4, 0x65,
-		0xc3, 0x21, 0x1f, 0x81, 0x1e, 0x84, 0xdb, 0xd6, 0x17, 0x17, 0x56, 0x2a, 0xf3, 0xd5, 0xe5, 0xf5,
-		0x05, 0xb1, 0x07, 0x2d, 0x5a, 0x37, 0xfa, 0xd2, 0xcd, 0xe9, 0x21, 0xde, 0xa4, 0x6e, 0xd4, 0x6b,
-		0x72, 0xe5, 0xda, 0xea, 0x46, 0x25, 0x27, 0x31, 0xea, 0x35, 0x1b, 0x5f, 0x37, 0x5d, 0xf6, 0xa9,
-		0xbc, 0x87, 0xe1, 0x58, 0x07, 0x6a, 0xaf, 0x61, 0x63, 0x2f, 0xdd, 0x9c, 0x1e, 0x5e, 0xb3, 0x31,
-		0x1b, 0x3f, 0x94, 0x63, 0x06, 0xf2, 0xed, 0x1c, 0xab, 0x6b, 0xab, 0xeb, 0xb3, 0x4b, 0xb9, 0xe9,
-		0x42, 0xee, 0xa5, 0x9b, 0xd3, 0x59, 0xe1, 0x0c, 0xe9, 0x11, 0x80, 0xd7, 0xb2, 0x5b, 0xb9, 0xe2,
-		0xf9, 0xdd, 0x07, 0xe0, 0x9e, 0x2e, 0xa7, 0x4f, 0xe2, 0xdc, 0xe2, 0x40, 0xe7, 0x4f, 0x5d, 0xf7,
-		0xd9, 0x0b, 0x11, 0xdb, 0xcf, 0xd1, 0x4b, 0xa7, 0x83, 0x9f, 0x6d, 0x15, 0x7a, 0x2e, 0xee, 0x8a,
-		0xef, 0x93, 0x60, 0xe4, 0xb2, 0xe6, 0xb8, 0xa6, 0xad, 0xa9, 0x8a, 0x4e, 0x9f, 0x83, 0x9d, 0xed,
-		0xd7, 0xb7, 0xb6, 0x0c, 0xf5, 0xc7, 0x21, 0x75, 0x5d, 0xd1, 0x99, 0x53, 0x8b, 0xd3, 0xef, 0xd9,
-		0x74, 0x39, 0x0c, 0xf2, 0x5c, 0x9b, 0x00, 0x60, 0x6c, 0xc5, 0x4f, 0xc7, 0x60, 0x94, 0x0e, 0x06,
-		0x87, 0x7d, 0xe9, 0x8c, 0xac, 0xb1, 0xd6, 0x20, 0x61, 0x2b, 0x2e, 0xdf, 0x34, 0x2c, 0xbf, 0x85,
-		0x9f, 0x52, 0xde, 0xd7, 0xc7, 0x29, 0x5b, 0xfb, 0x41, 0x26, 0x45, 0x42, 0x4f, 0x42, 0xba, 0xa1,
-		0xdc, 0xa8, 0x52, 0xd4, 0xd8, 0x21, 0xa0, 0x0e, 0x36, 0x94, 0x1b, 0x44, 0x56, 0x54, 0x83, 0x51,
-		0x02, 0xac, 0xee, 0x28, 0x46, 0x1d, 0x33, 0xfc, 0xf8, 0x21, 0xe0, 0x0f, 0x37, 0x94, 0x1b, 0x73,
-		0x14, 0x93, 0xd4, 0x52, 0x4a, 0x7f, 0xf0, 0xe5, 0xa9, 0x01, 0x7a, 0x08, 0xfc, 0xdb, 0x12, 0x80,
-		0xaf, 0x2e, 0xf4, 0x53, 0x90, 0x53, 0xbd, 0x14, 0xad, 0x5e, 0x1c, 0x59, 0x1e, 0xef, 0xd6, 0x11,
-		0x2d, 0xca, 0x66, 0x13, 0xf3, 0x57, 0x5f, 0x9d, 0x92, 0xe4, 0x51, 0xb5, 0xa5, 0x1f, 0x2a, 0x30,
-		0xd4, 0xb4, 0x6a, 0x8a, 0x8b, 0xab, 0x74, 0x11, 0x17, 0xdb, 0xc7, 0x24, 0x0f, 0x8c, 0x91, 0x14,
-		0x05, 0xa4, 0xff, 0xb4, 0x04, 0x43, 0xf3, 0x81, 0xfb, 0x98, 0x79, 0x18, 0x6c, 0x98, 0x86, 0xb6,
-		0xcb, 0xcd, 0x2e, 0x23, 0x8b, 0x24, 0x2a, 0x40, 0x9a, 0x3d, 0x84, 0x75, 0xf7, 0xc4, 0x8e, 0xa7,
-		0x48, 0x13, 0xae, 0xe7, 0xf0, 0x96, 0xa3, 0x09, 0x5d, 0xcb, 0x22, 0x49, 0x96, 0x2e, 0x0e, 0x56,
-		0x9b, 0xb6, 0xe6, 0xee, 0x55, 0x55, 0xd3, 0x70, 0x15, 0xd5, 0xe5, 0x4f, 0x2a, 0x47, 0x45, 0xfe,
-		0x1c, 0xcb, 0x26, 0x20, 0x35, 0xec, 0x2a, 0x9a, 0xee, 0xe4, 0xd9, 0x15, 0x06, 0x91, 0x0c, 0x88,
-		0xfb, 0xe5, 0x54, 0x70, 0x8b, 0x6a, 0x0e, 0x72, 0xa6, 0x85, 0xed, 0x50, 0x48, 0xc9, 0x2c, 0xb4,
-		0xfb, 0x21, 0xe5, 0xa8, 0xe0, 0x10, 0xb1, 0xe6, 0xd3, 0xa4, 0xc3, 0xc4, 0x7a, 0xcf, 0x6a, 0x6e,
-		0xf9, 0xdb, 0x5a, 0x13, 0x6d, 0x7a, 0x9d, 0x35, 0xf6, 0xca, 0xf9, 0xaf, 0xf8, 0xd0, 0xfe, 0x5e,
-		0xd2, 0x55, 0xbc, 0x47, 0x7a, 0x8b, 0xe3, 0xac, 0x51, 0x18, 0x12, 0x22, 0x3e, 0xab, 0x68, 0xba,
-		0x78, 0xdf, 0x2f, 0xf3, 0x14, 0x2a, 0x41, 0xca, 0x71, 0x15, 0xb7, 0xe9, 0xf0, 0xf3, 0xda, 0x62,
-		0x37, 0xcb, 0x28, 0x9b, 0x46, 0x6d, 0x9d, 0x52, 0xca, 0x9c, 0x03, 0x6d, 0x40, 0x8a, 0x1f, 0x84,
-		0x27, 0xf7, 0x6d, 0xd5, 0x1d, 0x6e, 0x4a, 0x30, 0x2c, 0x54, 0x87, 0x5c, 0x0d, 0xeb, 0xb8, 0xce,
-		0x02, 0xa2, 0x1d, 0x85, 0xac, 0x1b, 0x52, 0x87, 0x30, 0x6a, 0x46, 0x3d, 0xd4, 0x75, 0x0a, 0x8a,
-		0xae, 0x86, 0xae, 0xff, 0xf2, 0x8f, 0x56, 0xde, 0xdd, 0xad, 0xfd, 0x01, 0xcb, 0x14, 0x9b, 0x09,
-		0xc1, 0xcb, 0xc3, 0xf7, 0x43, 0xae, 0x69, 0x6c, 0x99, 0x06, 0x7d, 0x85, 0xcb, 0x83, 0xf1, 0x34,
-		0x0d, 0x6f, 0x46, 0xbd, 0xfc, 0xcb, 0x2c, 0x2a, 0xbf, 0x0a, 0x23, 0x3e, 0x29, 0x1d, 0x3b, 0x99,
-		0x7d, 0x8c, 0x9d, 0x61, 0x8f, 0x97, 0x94, 0xa2, 0xcb, 0x00, 0xfe, 0xc0, 0xa4, 0xdb, 0x03, 0x43,
-		0xdd, 0xfb, 0xd0, 0x1f, 0xdd, 0x62, 0x99, 0xe5, 0xf3, 0x22, 0x1d, 0xc6, 0x1b, 0x9a, 0x51, 0x75,
-		0xb0, 0xbe, 0x5d, 0xe5, 0xaa, 0x22, 0x90, 0x43, 0x87, 0xd0, 0xb5, 0x63, 0x0d, 0xcd, 0x58, 0xc7,
-		0xfa, 0xf6, 0xbc, 0x07, 0x5b, 0xca, 0xbe, 0xf8, 0xf2, 0xd4, 0x00, 0x1f, 0x4b, 0x03, 0xc5, 0x35,
-		0xba, 0x45, 0xcd, 0x87, 0x01, 0x76, 0xd0, 0x59, 0xc8, 0x28, 0x22, 0x11, 0x79, 0xd6, 0xef, 0x93,
-		0xb2, 0xd1, 0xf9, 0xc2, 0x9f, 0x4c, 0x4b, 0xc5, 0x4f, 0x48, 0x90, 0x9a, 0xbf, 0xb6, 0xa6, 0x68,
-		0x36, 0xaa, 0xc0, 0x98, 0x6f, 0x50, 0xfd, 0x8e, 0x4d, 0xdf, 0x06, 0xc5, 0xe0, 0xac, 0x74, 0x5b,
-		0x35, 0xf6, 0x84, 0x69, 0x5d, 0x4f, 0xb6, 0x34, 0xbc, 0x02, 0x83, 0x4c, 0x4a, 0x07, 0x95, 0x20,
-		0x69, 0x91, 0x1f, 0x7c, 0x47, 0x7e, 0xb2, 0xab, 0x21, 0x52, 0x7a, 0x6f, 0x07, 0x91, 0xb0, 0x14,
-		0x7f, 0x24, 0x01, 0xcc, 0x5f, 0xbb, 0xb6, 0x61, 0x6b, 0x96, 0x8e, 0xdd, 0xc3, 0x6a, 0xf1, 0x12,
-		0x1c, 0x09, 0x2c, 0x4d, 0x6c, 0xb5, 0xef, 0x56, 0x8f, 0xfb, 0x8b, 0x13, 0x5b, 0xed, 0x88, 0x56,
-		0x73, 0x5c, 0x0f, 0x2d, 0xde, 0x37, 0xda, 0xbc, 0xe3, 0x76, 0x56, 0xe3, 0x3a, 0x0c, 0xf9, 0xcd,
-		0x77, 0xd0, 0x3c, 0xa4, 0x5d, 0xfe, 0x9b, 0x6b, 0xb3, 0xd8, 0x5d, 0x9b, 0x82, 0x8d, 0x6b, 0xd4,
-		0xe3, 0x2c, 0xfe, 0x1f, 0xa2, 0x54, 0xcf, 0x62, 0xdf, 0x5c, 0x66, 0x44, 0x7c, 0x2f, 0xf7, 0x8d,
-		0x87, 0x11, 0x51, 0x70, 0xac, 0x16, 0xad, 0xbe, 0x37, 0x06, 0xe3, 0x9b, 0xc2, 0xdb, 0xbc, 0x69,
-		0x35, 0xb1, 0x06, 0x83, 0xd8, 0x70, 0x6d, 0x8d, 0xaa, 0x82, 0xf4, 0xf5, 0xc3, 0xdd, 0xfa, 0xba,
-		0x43, 0x5b, 0xe8, 0xe7, 0xa0, 0xc4, 0xbe, 0x36, 0x87, 0x69, 0xd1, 0xc2, 0x7f, 0x8c, 0x41, 0xbe,
-		0x1b, 0x27, 0x3a, 0x0e, 0xa3, 0xaa, 0x8d, 0xd9, 0xad, 0xa7, 0xd0, 0xe6, 0xda, 0x88, 0xc8, 0xe6,
-		0x4e, 0x7f, 0x19, 0x48, 0x00, 0x45, 0x0c, 0x8b, 0x5e, 0x90, 0xda, 0x6f, 0xc4, 0x34, 0xe2, 0x33,
-		0x53, 0xb7, 0x8f, 0x61, 0x54, 0x33, 0x34, 0x57, 0x53, 0xf4, 0xea, 0x96, 0xa2, 0x2b, 0x86, 0x7a,
-		0x90, 0xc8, 0xb2, 0xdd, 0x51, 0x8f, 0x70, 0xd0, 0x32, 0xc3, 0x44, 0xd7, 0x60, 0x50, 0xc0, 0x27,
-		0x0e, 0x01, 0x5e, 0x80, 0x05, 0xa2, 0xa8, 0xaf, 0xc7, 0x60, 0x4c, 0xc6, 0xb5, 0x9f, 0x2c, 0xb5,
-		0xbe, 0x03, 0x80, 0x0d, 0x38, 0xe2, 0x07, 0x0f, 0xa0, 0xd9, 0xf6, 0x01, 0x9c, 0x61, 0x78, 0xf3,
-		0x8e, 0x1b, 0xd0, 0xed, 0x57, 0x62, 0x90, 0x0d, 0xea, 0xf6, 0x27, 0x60, 0x5e, 0x40, 0x8b, 0xbe,
-		0x37, 0x48, 0xf0, 0x0f, 0xd9, 0x76, 0xf1, 0x06, 0x6d, 0x56, 0xd7, 0xdb, 0x0d, 0xfc, 0x20, 0x06,
-		0xa9, 0x35, 0xc5, 0x56, 0x1a, 0x0e, 0xba, 0xd2, 0x16, 0xc0, 0x89, 0x5d, 0xb6, 0xb6, 0xcf, 0x95,
-		0xf3, 0x45, 0x3d, 0x33, 0xb9, 0x0f, 0x76, 0x88, 0xdf, 0xee, 0x85, 0x11, 0xb2, 0x44, 0x0c, 0x1c,
-		0xc8, 0xc7, 0xe8, 0x31, 0x23, 0x59, 0xe3, 0x05, 0xae, 0x3e, 0x4e, 0xc1, 0x10, 0x21, 0xf3, 0x1d,
-		0x1d, 0xa1, 0x81, 0x86, 0x72, 0xa3, 0xc2, 0x72, 0xd0, 0x43, 0x80, 0x76, 0xbc, 0x45, 0x7b, 0xd5,
-		0x57, 0x01, 0xa1, 0x1b, 0xf3, 0x4b, 0x04, 0xf9, 0x9d, 0x00, 0x44, 0x8a, 0x2a, 0xbb, 0x82, 0xcc,
-		0xd6, 0x38, 0x19, 0x92, 0x33, 0x4f, 0xaf, 0x21, 0xff, 0x0c, 0x8b, 0x05, 0x5b, 0x56, 0x8f, 0x3c,
-		0x0c, 0x5f, 0xda, 0x9f, 0xa5, 0xfe, 0xe0, 0xd5, 0xa9, 0xc2, 0x9e, 0xd2, 0xd0, 0x4b, 0xc5, 0x0e,
-		0x90, 0x45, 0x1a, 0x1b, 0x86, 0x57, 0x9d, 0x01, 0x0b, 0xfe, 0xb8, 0x04, 0xc8, 0x77, 0xb9, 0x32,
-		0x76, 0x2c, 0xb2, 0xac, 0x21, 0x41, 0x6f, 0x20, 0x42, 0x95, 0x7a, 0x07, 0xbd, 0x3e, 0xbf, 0x08,
-		0x7a, 0x03, 0x23, 0xe2, 0x82, 0xef, 0xe0, 0x62, 0x51, 0x97, 0x79, 0xb9, 0x79, 0xb4, 0xfa, 0xb0,
-		0x81, 0xe2, 0xd7, 0x25, 0x38, 0xd6, 0x66, 0x4d, 0x9e, 0xb0, 0xff, 0x1f, 0x20, 0x3b, 0x50, 0xc8,
-		0xbf, 0x48, 0xc8, 0x84, 0xde, 0xb7, 0x71, 0x8e, 0xd9, 0x6d, 0xbe, 0xf2, 0x56, 0xf9, 0x68, 0x76,
-		0xaf, 0xfc, 0x5f, 0x49, 0x30, 0x11, 0x14, 0xc6, 0x6b, 0xd6, 0x0a, 0x64, 0x83, 0xb2, 0xf0, 0x06,
-		0xdd, 0xd3, 0x4f, 0x83, 0x78, 0x5b, 0x42, 0xfc, 0xe8, 0x09, 0x7f, 0xe0, 0xb2, 0xcd, 0xa2, 0x47,
-		0xfa, 0xd6, 0x8d, 0x90, 0xa9, 0x75, 0x00, 0x27, 0x68, 0xef, 0xfc, 0xa9, 0x04, 0x89, 0x35, 0xd3,
-		0xd4, 0xd1, 0x0e, 0x8c, 0x19, 0xa6, 0x5b, 0x25, 0x56, 0x8e, 0x6b, 0xc1, 0x2b, 0xdc, 0x6f, 0x54,
-		0x65, 0xa3, 0x86, 0xe9, 0x96, 0x29, 0x2a, 0xbf, 0xbe, 0xad, 0xc0, 0x70, 0xb8, 0x96, 0xd8, 0x21,
-		0xd4, 0x92, 0xdd, 0x0a, 0x54, 0xc1, 0x6e, 0x2b, 0x7d, 0xff, 0xe5, 0x29, 0xe9, 0xe4, 0xe7, 0x25,
-		0x00, 0x7f, 0x71, 0x8e, 0x1e, 0x84, 0xdb, 0xca, 0xab, 0x2b, 0xf3, 0xd5, 0xf5, 0x8d, 0xd9, 0x8d,
-		0xcd, 0xf5, 0xf0, 0x95, 0x65, 0xb1, 0xdb, 0xeb, 0x58, 0x58, 0xd5, 0xb6, 0x35, 0x5c, 0x43, 0xf7,
-		0xc1, 0x44, 0x98, 0x9a, 0xa4, 0x2a, 0xf3, 0x39, 0xa9, 0x90, 0x7d, 0xe9, 0xe6, 0x74, 0x9a, 0xc5,
-		0x3d, 0xb8, 0x86, 0x4e, 0xc0, 0x91, 0x76, 0xba, 0xc5, 0x95, 0x85, 0x5c, 0xac, 0x30, 0xfc, 0xd2,
-		0xcd, 0xe9, 0x8c, 0x17, 0x20, 0xa1, 0x22, 0xa0, 0x20, 0x25, 0xc7, 0x8b, 0x17, 0xe0, 0xa5, 0x9b,
-		0xd3, 0x29, 0xa6, 0xa5, 0x42, 0xe2, 0xc5, 0x8f, 0x4f, 0x0e, 0x1c, 0xfa, 0xc5, 0xe6, 0x2f, 0x0f,
-		0x76, 0xdd, 0xc4, 0xad, 0x63, 0x03, 0x3b, 0x9a, 0x73, 0xa0, 0x4d, 0xdc, 0xbe, 0x36, 0x86, 0x7b,
-		0xbd, 0x25, 0xf9, 0xcb, 0x04, 0x64, 0x17, 0x98, 0x00, 0xa4, 0x8f, 0x30, 0x7a, 0x0b, 0xa4, 0x2c,
-		0x3a, 0x93, 0x78, 0x07, 0x46, 0x5d, 0x2c, 0x9d, 0xcd, 0x37, 0xde, 0xad, 0x25, 0x36, 0xfb, 0x3c,
-		0xc5, 0xaf, 0x2d, 0xb0, 0xdb, 0x54, 0xfe, 0xfd, 0xa0, 0x6c, 0x79, 0x66, 0x7f, 0xf6, 0xc5, 0xae,
-		0x39, 0x6c, 0x10, 0x18, 0x76, 0xd9, 0xa9, 0x06, 0x47, 0x28, 0xb2, 0x3f, 0x1d, 0x53, 0x74, 0x11,
-		0x57, 0x9f, 0xec, 0x26, 0xe6, 0x92, 0xe2, 0xf8, 0x37, 0x17, 0xd8, 0xed, 0x24, 0x26, 0xf2, 0xb8,
-		0xde, 0x56, 0xe2, 0xa0, 0x85, 0xd0, 0xf5, 0xb3, 0xc4, 0xfe, 0x36, 0x86, 0x83, 0x57, 0xd1, 0xae,
-		0xc0, 0x90, 0xef, 0x08, 0x1c, 0xfe, 0xcf, 0x50, 0xfa, 0x9f, 0x06, 0x82, 0xcc, 0x68, 0x1b, 0x8e,
-		0xf8, 0x53, 0x7a, 0x10, 0x95, 0xfd, 0xcf, 0x98, 0x07, 0xf6, 0xb1, 0xa4, 0xe0, 0xf0, 0x13, 0xcd,
-		0xf6, 0x22, 0xb2, 0x58, 0x19, 0x0e, 0x7a, 0x3d, 0x27, 0x2f, 0x3e, 0x72, 0xd8, 0xbf, 0xdb, 0x0c,
-		0x03, 0xb0, 0xff, 0x53, 0x61, 0x99, 0xb6, 0x8b, 0x6b, 0x74, 0xc3, 0x29, 0x2d, 0x7b, 0xe9, 0xe2,
-		0x0e, 0xa0, 0xf6, 0xbe, 0x09, 0x3f, 0xa3, 0x90, 0xfa, 0x7a, 0x46, 0x81, 0x26, 0x20, 0x19, 0xbc,
-		0x89, 0xc6, 0x12, 0xa5, 0xf4, 0x8b, 0x7c, 0x0a, 0x3c, 0xf4, 0xb1, 0xfc, 0x8d, 0x18, 0x9c, 0x0c,
-		0x9e, 0x62, 0xbc, 0xb3, 0x89, 0xed, 0x3d, 0x6f, 0xe8, 0x59, 0x4a, 0x5d, 0x33, 0x82, 0x97, 0xf5,
-		0x8f, 0x05, 0x27, 0x6d, 0x4a, 0x2b, 0x34, 0x58, 0x7c, 0x51, 0x82, 0xa1, 0x35, 0xa5, 0x8e, 0x65,
-		0xfc, 0xce, 0x26, 0x76, 0xdc, 0x0e, 0x97, 0xa1, 0x8f, 0x42, 0xca, 0xdc, 0xde, 0x16, 0x47, 0xaf,
-		0x09, 0x99, 0xa7, 0x48, 0x9b, 0x75, 0xad, 0xa1, 0xb1, 0x5b, 0x4b, 0x09, 0x99, 0x25, 0x48, 0x24,
-		0xa6, 0x9a, 0x4d, 0x83, 0x8f, 0xbf, 0x7c, 0x42, 0x7c, 0x67, 0xa4, 0x69, 0xb0, 0xa1, 0x84, 0xf2,
-		0x30, 0x68, 0xe3, 0xeb, 0xd8, 0x76, 0xd8, 0x97, 0x15, 0xd3, 0xb2, 0x48, 0x16, 0x1f, 0x87, 0x2c,
-		0x93, 0x84, 0x4f, 0xa1, 0xc7, 0x20, 0x4d, 0x2f, 0x04, 0xf9, 0xf2, 0x0c, 0x92, 0xf4, 0x55, 0x76,
-		0xa5, 0x9a, 0xe1, 0x33, 0x91, 0x58, 0xa2, 0x5c, 0xee, 0xaa, 0xe5, 0x13, 0xd1, 0x43, 0x9e, 0xe9,
-		0xd0, 0xd3, 0xf0, 0xef, 0x25, 0xe1, 0x08, 0x3f, 0x63, 0x52, 0x2c, 0xed, 0xd4, 0x8e, 0xeb, 0x8a,
-		0x2b, 0xfe, 0xc0, 0xa3, 0x58, 0xc5, 0xd2, 0x8a, 0x7b, 0x90, 0xb8, 0xec, 0xba, 0x16, 0x3a, 0x09,
-		0x49, 0xbb, 0xa9, 0x63, 0xb1, 0x9d, 0xe2, 0x6d, 0x47, 0x2b, 0x96, 0x36, 0x43, 0x08, 0xe4, 0xa6,
-		0x8e, 0x65, 0x46, 0x82, 0x2a, 0x30, 0xb5, 0xdd, 0xd4, 0xf5, 0xbd, 0x6a, 0x0d, 0xd3, 0x7f, 0x1e,
-		0xe5, 0xfd, 0xfb, 0x05, 0x7c, 0xc3, 0x52, 0xc4, 0x27, 0x1b, 0x89, 0x62, 0xee, 0xa0, 0x64, 0xf3,
-		0x94, 0x4a, 0xfc, 0xeb, 0x85, 0x8a, 0xa0, 0x29, 0xfe, 0x71, 0x0c, 0xd2, 0x02, 0x9a, 0xde, 0x71,
-		0xc6, 0x3a, 0x56, 0x5d, 0x53, 0x1c, 0x06, 0x78, 0x69, 0x84, 0x20, 0x5e, 0xe7, 0x9d, 0x97, 0xb9,
-		0x3c, 0x20, 0x93, 0x04, 0xc9, 0xf3, 0x6e, 0x9e, 0x93, 0x3c, 0xab, 0x49, 0xfa, 0x33, 0x61, 0x99,
-		0x62, 0xbd, 0x75, 0x79, 0x40, 0xa6, 0x29, 0x94, 0x87, 0x14, 0x19, 0x4e, 0x2e, 0xeb, 0x2d, 0x92,
-		0xcf, 0xd3, 0xe8, 0x28, 0x24, 0x2d, 0xc5, 0x55, 0xd9, 0xa5, 0x30, 0x52, 0xc0, 0x92, 0xe8, 0x1c,
-		0xa4, 0xd8, 0x8b, 0xe4, 0xd6, 0xff, 0xcc, 0x42, 0x94, 0xc1, 0x3e, 0xfd, 0x46, 0xe4, 0x5e, 0x53,
-		0x5c, 0x17, 0xdb, 0x06, 0x01, 0x64, 0xe4, 0x08, 0x41, 0x62, 0xcb, 0xac, 0xed, 0xf1, 0xff, 0x16,
-		0x43, 0x7f, 0xf3, 0x7f, 0x4f, 0x41, 0xed, 0xa1, 0x4a, 0x0b, 0xd9, 0x3f, 0xc9, 0xca, 0x8a, 0xcc,
-		0x32, 0x21, 0xaa, 0xc0, 0xb8, 0x52, 0xab, 0x69, 0xec, 0x1f, 0xb7, 0x54, 0xb7, 0x34, 0xea, 0x56,
-		0x1c, 0xfa, 0x2f, 0xd0, 0xba, 0xf5, 0x05, 0xf2, 0x19, 0xca, 0x9c, 0xbe, 0x9c, 0x81, 0x41, 0x8b,
-		0x09, 0x55, 0xbc, 0x08, 0x63, 0x6d, 0x92, 0x12, 0xf9, 0x76, 0x35, 0xa3, 0x26, 0xae, 0xe3, 0x93,
-		0xdf, 0x24, 0x8f, 0x7e, 0xac, 0x91, 0x1d, 0xb3, 0xd0, 0xdf, 0xe5, 0x9f, 0xed, 0xfe, 0x6a, 0x63,
-		0x24, 0xf0, 0x6a, 0x43, 0xb1, 0xb4, 0x72, 0x86, 0xe2, 0xf3, 0xb7, 0x1a, 0xb3, 0xed, 0x6f, 0x35,
-		0xea, 0xd8, 0x10, 0x13, 0x2e, 0x29, 0x52, 0x2c, 0xcd, 0xa1, 0xe6, 0xe8, 0x7f, 0x3c, 0xd2, 0xb9,
-		0x18, 0xf8, 0x4d, 0x9f, 0x6e, 0x24, 0x16, 0x66, 0xd7, 0x16, 0x3d, 0x3b, 0xfe, 0x62, 0x0c, 0xee,
-		0x08, 0xd8, 0x71, 0x80, 0xb8, 0xdd, 0x9c, 0x0b, 0x9d, 0x2d, 0xbe, 0x8f, 0x77, 0xb9, 0x57, 0x21,
-		0x41, 0xe8, 0x51, 0xc4, 0x3f, 0x8f, 0xc8, 0x7f, 0xe6, 0x2b, 0xff, 0xb2, 0x18, 0x3e, 0xb0, 0x09,
-		0xf5, 0x0a, 0x05, 0x29, 0xff, 0x5c, 0xff, 0xfa, 0xcb, 0xf9, 0xdf, 0xcd, 0x74, 0x0e, 0x4f, 0x8d,
-		0xad, 0x3a, 0x7c, 0xf1, 0x42, 0xd7, 0xc7, 0x97, 0xcc, 0x99, 0xf6, 0x8e, 0x9b, 0xf6, 0xe1, 0xa9,
-		0xbb, 0xdd, 0x60, 0xef, 0xd5, 0x83, 0x6f, 0x3c, 0x02, 0xbb, 0x01, 0x47, 0x9f, 0x20, 0x62, 0xf9,
-		0x0b, 0x6a, 0x31, 0x1b, 0x1c, 0xf5, 0x8e, 0xbf, 0x24, 0xfe, 0x54, 0x99, 0x45, 0xd1, 0x97, 0x00,
-		0x7c, 0xd1, 0xf9, 0xd2, 0xf0, 0xbe, 0x99, 0xae, 0xb3, 0xcc, 0x4c, 0x60, 0x86, 0x91, 0x03, 0x9c,
-		0xc5, 0x5f, 0x91, 0xe0, 0xb6, 0xb6, 0xaa, 0xb9, 0xfb, 0x5f, 0xe8, 0x70, 0x0f, 0xff, 0x40, 0x81,
-		0xd0, 0x42, 0x07, 0x61, 0x8f, 0x47, 0x0a, 0xcb, 0xa4, 0x08, 0x49, 0xfb, 0x14, 0x1c, 0x09, 0x0b,
-		0x2b, 0xd4, 0xf4, 0x38, 0x8c, 0x84, 0xb7, 0x6a, 0x23, 0x23, 0x87, 0xe1, 0xd0, 0x3e, 0x6d, 0xb1,
-		0xda, 0xda, 0x03, 0x9e, 0x16, 0x2a, 0x90, 0xf1, 0x48, 0x79, 0x3c, 0xdc, 0xb7, 0x12, 0x7c, 0x4e,
-		0xa2, 0xe8, 0xe9, 0x70, 0x0d, 0x81, 0xb0, 0xeb, 0xb0, 0x9a, 0x71, 0x68, 0x66, 0xf1, 0x1d, 0x09,
-		0xee, 0xea, 0x21, 0x2d, 0x57, 0xcd, 0xf3, 0x30, 0x11, 0xd8, 0x37, 0x10, 0x33, 0x82, 0x30, 0x95,
-		0x93, 0xd1, 0x91, 0xae, 0xb7, 0x30, 0xbe, 0x9d, 0xa8, 0xeb, 0x53, 0xdf, 0x98, 0x1a, 0x6f, 0x2f,
-		0x73, 0xe4, 0xf1, 0xf6, 0xd5, 0xfd, 0x21, 0xda, 0xd4, 0x2b, 0x12, 0xdc, 0x1f, 0x6e, 0x6a, 0x87,
-		0x98, 0xf9, 0xcd, 0xd7, 0x43, 0x5f, 0x97, 0xe0, 0x64, 0x3f, 0x62, 0xf3, 0xae, 0xda, 0x82, 0x71,
-		0x7f, 0xfd, 0xd0, 0xda, 0x53, 0x07, 0x58, 0x3d, 0x20, 0x0f, 0xed, 0x16, 0x74, 0xc9, 0x27, 0x24,
-		0x3e, 0x1a, 0x83, 0xd6, 0xe0, 0xe9, 0x3f, 0xbc, 0x43, 0x1c, 0xad, 0xff, 0xd0, 0xf6, 0x70, 0x87,
-		0x0e, 0x8c, 0xed, 0xab, 0x03, 0xfd, 0x35, 0x45, 0xf1, 0x3a, 0x77, 0x9d, 0x1d, 0x36, 0x00, 0xdf,
-		0x01, 0xe3, 0x1d, 0x46, 0x06, 0x77, 0x1f, 0xfb, 0x18, 0x18, 0x32, 0x6a, 0xb7, 0xfd, 0xe2, 0xaf,
-		0x4a, 0x30, 0x45, 0x2b, 0xee, 0xd0, 0x3d, 0x6f, 0x46, 0x3d, 0x35, 0xb8, 0xe7, 0xeb, 0x28, 0x2e,
-		0x57, 0xd8, 0x22, 0xa4, 0x98, 0x45, 0x71, 0x1d, 0x1d, 0xc0, 0x24, 0x39, 0x40, 0xf1, 0x73, 0xc2,
-		0xd3, 0xce, 0x8b, 0x06, 0x75, 0x1e, 0xc7, 0x6f, 0x4c, 0x3f, 0x87, 0x34, 0x8e, 0x03, 0x6a, 0xfa,
-		0x9a, 0xf0, 0xb9, 0x9d, 0xe5, 0xe6, 0x8a, 0x52, 0x0f, 0xcd, 0xe7, 0xf2, 0x2d, 0x90, 0x5b, 0xea,
-		0x5c, 0x7f, 0x47, 0x38, 0x57, 0xaf, 0x4d, 0x11, 0xce, 0xf5, 0xcd, 0xd6, 0x29, 0x9e, 0x9b, 0x8d,
-		0x68, 0xc0, 0x5f, 0x47, 0x37, 0xfb, 0x3b, 0x31, 0x38, 0x46, 0xdb, 0x16, 0xdc, 0xc4, 0x39, 0xcc,
-		0xce, 0x40, 0x8e, 0xad, 0x56, 0xf7, 0xe9, 0x45, 0x72, 0x8e, 0xad, 0x5e, 0x6b, 0x99, 0x31, 0x51,
-		0x2d, 0xb4, 0xe9, 0x47, 0x71, 0xe2, 0x91, 0xa7, 0x82, 0x81, 0x7d, 0xa5, 0x0e, 0xc6, 0x91, 0x38,
-		0x04, 0xe3, 0xf8, 0xaa, 0x04, 0x85, 0x4e, 0x0a, 0xe4, 0xc6, 0xa0, 0xc1, 0xd1, 0xd0, 0xc1, 0x4a,
-		0xab, 0x3d, 0x3c, 0xd8, 0xcf, 0xa6, 0x5a, 0xcb, 0x70, 0x3d, 0x62, 0xe3, 0x5b, 0x1d, 0x0d, 0x4d,
-		0x85, 0xed, 0xbd, 0x7d, 0x4d, 0xf2, 0x26, 0x1c, 0xa6, 0xaf, 0xb4, 0xf9, 0xfc, 0xbf, 0x16, 0xeb,
-		0x99, 0x4f, 0x4b, 0x30, 0xd9, 0x45, 0xec, 0x37, 0xe3, 0x44, 0xbe, 0xd3, 0xd5, 0x36, 0x0e, 0x7b,
-		0xb5, 0xf4, 0x18, 0x1f, 0x58, 0xe1, 0x7b, 0xde, 0x81, 0x45, 0x71, 0xa7, 0x87, 0x62, 0xc5, 0xa7,
-		0xe1, 0xf6, 0x8e, 0x5c, 0x5c, 0xb6, 0x12, 0x24, 0x76, 0x34, 0xc7, 0xe5, 0x62, 0xdd, 0xd7, 0x4d,
-		0xac, 0x16, 0x6e, 0xca, 0x53, 0x44, 0x90, 0xa3, 0xd0, 0x6b, 0xa6, 0xa9, 0x73, 0x31, 0x8a, 0x57,
-		0x61, 0x2c, 0x90, 0xc7, 0x2b, 0x39, 0x0b, 0x09, 0xcb, 0xe4, 0x1f, 0x41, 0x18, 0x3a, 0x7d, 0x47,
-		0xd7, 0x93, 0x13, 0xd3, 0xd4, 0x79, 0xb3, 0x29, 0x7d, 0x71, 0x02, 0x10, 0x03, 0xa3, 0x87, 0x28,
-		0xa2, 0x8a, 0x75, 0x18, 0x0f, 0xe5, 0xf2, 0x4a, 0xde, 0xd0, 0x01, 0xcd, 0xe9, 0xef, 0x1e, 0x81,
-		0x24, 0x45, 0x45, 0x1f, 0x92, 0x42, 0xdf, 0x2f, 0x9a, 0xe9, 0x06, 0xd3, 0x79, 0x73, 0xa2, 0x70,
-		0xaa, 0x6f, 0x7a, 0x1e, 0xb9, 0x9e, 0xfc, 0xd9, 0x7f, 0xf7, 0xed, 0x0f, 0xc4, 0xee, 0x41, 0xc5,
-		0x53, 0x5d, 0x76, 0x4c, 0x02, 0x83, 0xec, 0x93, 0xa1, 0x17, 0xf6, 0x0f, 0xf5, 0x57, 0x95, 0x90,
-		0x6c, 0xa6, 0x5f, 0x72, 0x2e, 0xd8, 0x45, 0x2a, 0xd8, 0x19, 0xf4, 0x68, 0xb4, 0x60, 0xa7, 0xde,
-		0x15, 0x1e, 0x4e, 0xef, 0x46, 0xff, 0x5e, 0x82, 0x89, 0x4e, 0xeb, 0x64, 0x74, 0xbe, 0x3f, 0x29,
-		0xda, 0x23, 0xa1, 0xc2, 0x85, 0x03, 0x70, 0xf2, 0xa6, 0x2c, 0xd0, 0xa6, 0xcc, 0xa2, 0xc7, 0x0f,
-		0xd0, 0x94, 0x53, 0xc1, 0x83, 0x9b, 0xff, 0x2d, 0xc1, 0x9d, 0x3d, 0x17, 0x97, 0x68, 0xb6, 0x3f,
-		0x29, 0x7b, 0x84, 0x7c, 0x85, 0xf2, 0x1b, 0x81, 0xe0, 0x2d, 0x7e, 0x82, 0xb6, 0xf8, 0x2a, 0x5a,
-		0x3c, 0x48, 0x8b, 0x3b, 0x9e, 0xaa, 0xa1, 0xdf, 0x0f, 0x5f, 0xad, 0xec, 0x6d, 0x4e, 0x6d, 0xab,
-		0xaf, 0x88, 0x81, 0xd1, 0x1e, 0x8b, 0x17, 0x9f, 0xa2, 0x4d, 0x90, 0xd1, 0xda, 0x1b, 0xec, 0xb4,
-		0x53, 0xef, 0x0a, 0x4f, 0x16, 0xef, 0x46, 0x7f, 0x29, 0x75, 0xbe, 0x23, 0x79, 0xae, 0xa7, 0x88,
-		0xdd, 0x57, 0x96, 0x85, 0xf3, 0xfb, 0x67, 0xe4, 0x8d, 0x6c, 0xd0, 0x46, 0xd6, 0x11, 0x3e, 0xec,
-		0x46, 0x76, 0xec, 0x44, 0xf4, 0x65, 0x09, 0x26, 0x3a, 0x2d, 0xa5, 0x22, 0x86, 0x65, 0x8f, 0x55,
-		0x63, 0xc4, 0xb0, 0xec, 0xb5, 0x6e, 0x2b, 0xbe, 0x85, 0x36, 0xfe, 0x2c, 0x7a, 0xac, 0x5b, 0xe3,
-		0x7b, 0xf6, 0x22, 0x19, 0x8b, 0x3d, 0x57, 0x20, 0x11, 0x63, 0xb1, 0x9f, 0xe5, 0x57, 0xc4, 0x58,
-		0xec, 0x6b, 0x01, 0x14, 0x3d, 0x16, 0xbd, 0x96, 0xf5, 0xd9, 0x8d, 0x0e, 0xfa, 0xa2, 0x04, 0xc3,
-		0xa1, 0x00, 0x1b, 0x3d, 0xd2, 0x53, 0xd0, 0x4e, 0xab, 0x99, 0xc2, 0xe9, 0xfd, 0xb0, 0xf0, 0xb6,
-		0x2c, 0xd2, 0xb6, 0xcc, 0xa1, 0xd9, 0x83, 0xb4, 0x25, 0x7c, 0x08, 0xfe, 0x55, 0x09, 0xc6, 0x3b,
-		0x84, 0xa6, 0x11, 0xa3, 0xb0, 0x7b, 0x0c, 0x5e, 0x38, 0xbf, 0x7f, 0x46, 0xde, 0xaa, 0x4b, 0xb4,
-		0x55, 0x6f, 0x47, 0x6f, 0x3b, 0x48, 0xab, 0x02, 0xf3, 0xf3, 0xab, 0xfe, 0xd5, 0xb7, 0x40, 0x3d,
-		0xe8, 0xec, 0x3e, 0x05, 0x13, 0x0d, 0x3a, 0xb7, 0x6f, 0x3e, 0xde, 0x9e, 0x27, 0x69, 0x7b, 0x9e,
-		0x40, 0xab, 0x6f, 0xac, 0x3d, 0xed, 0xd3, 0xfa, 0x67, 0xdb, 0xdf, 0x19, 0xf6, 0xb6, 0xa2, 0x8e,
-		0xc1, 0x6a, 0xe1, 0xd1, 0x7d, 0xf1, 0xf0, 0x46, 0x9d, 0xa7, 0x8d, 0x3a, 0x8d, 0x1e, 0xee, 0xd6,
-		0xa8, 0xc0, 0x5d, 0x4a, 0xcd, 0xd8, 0x36, 0x4f, 0xbd, 0x8b, 0x85, 0xc0, 0xef, 0x46, 0xef, 0x11,
-		0xb7, 0xc9, 0x4e, 0xf4, 0xac, 0x37, 0x10, 0xc7, 0x16, 0xee, 0xef, 0x83, 0x92, 0xcb, 0x75, 0x0f,
-		0x95, 0x6b, 0x12, 0xdd, 0xd1, 0x4d, 0x2e, 0x12, 0xcb, 0xa2, 0xf7, 0x49, 0xde, 0x55, 0xd4, 0x93,
-		0xbd, 0xb1, 0x83, 0xc1, 0x6e, 0xe1, 0x81, 0xbe, 0x68, 0xb9, 0x24, 0xf7, 0x51, 0x49, 0xa6, 0xd1,
-		0x64, 0x57, 0x49, 0x58, 0xe8, 0x7b, 0xd8, 0x17, 0x3f, 0x7e, 0xe3, 0x28, 0x4c, 0x75, 0xa9, 0xd1,
-		0xbd, 0x11, 0x71, 0x0e, 0xd9, 0xe3, 0xb9, 0x6d, 0xe4, 0x73, 0xda, 0xc3, 0xfe, 0x80, 0x6c, 0x7f,
-		0x87, 0x96, 0xc5, 0xcf, 0x25, 0x00, 0x2d, 0x3b, 0xf5, 0x39, 0x1b, 0xb3, 0xff, 0x83, 0xc9, 0x47,
-		0x79, 0xcb, 0xd3, 0x34, 0xe9, 0x0d, 0x3d, 0x4d, 0x5b, 0x0e, 0x3d, 0x11, 0x8b, 0xed, 0xef, 0x01,
-		0x68, 0xdf, 0xef, 0xc4, 0xe2, 0xb7, 0xe4, 0x9d, 0x58, 0xe7, 0x2b, 0xeb, 0x89, 0xc3, 0x79, 0x6b,
-		0x92, 0xdc, 0xf7, 0x5b, 0x93, 0x4b, 0x90, 0xe2, 0xcf, 0x32, 0x53, 0x07, 0x7a, 0x96, 0xc9, 0xb9,
-		0xd1, 0x19, 0xf1, 0x71, 0xd4, 0xc1, 0xfe, 0x2e, 0x1d, 0xf3, 0xaf, 0xa7, 0xfa, 0x5b, 0x05, 0x77,
-		0x40, 0xa1, 0xdd, 0x6c, 0xbc, 0xc1, 0xfb, 0xa3, 0x18, 0xe4, 0x96, 0x9d, 0x7a, 0xa5, 0xa6, 0xb9,
-		0xb7, 0xc8, 0xa6, 0x0e, 0xe9, 0xed, 0x8e, 0x02, 0xa3, 0xad, 0x77, 0xcc, 0x99, 0x1d, 0x9d, 0x3f,
-		0xf0, 0x4b, 0x88, 0x91, 0xf0, 0x3b, 0x65, 0xb4, 0xd3, 0xd9, 0x5c, 0x13, 0xfb, 0xaa, 0xa6, 0xaf,
-		0x27, 0x8d, 0x7e, 0xef, 0x14, 0x20, 0xdf, 0xaa, 0x7e, 0xaf, 0x6f, 0x5e, 0x95, 0x60, 0x68, 0xd9,
-		0x11, 0xc1, 0x1d, 0x7e, 0x93, 0x3d, 0xa6, 0x3a, 0xe7, 0x7d, 0x7f, 0x3c, 0xde, 0x9f, 0x65, 0x8a,
-		0xaf, 0x90, 0xfb, 0x8d, 0x3f, 0x02, 0xe3, 0x81, 0xf6, 0x79, 0xed, 0xfe, 0xad, 0x18, 0xf5, 0x74,
-		0x65, 0x5c, 0xd7, 0x0c, 0x2f, 0x1e, 0xc4, 0x3f, 0x09, 0x4f, 0x52, 0x7c, 0x9d, 0x26, 0x0e, 0xaa,
-		0xd3, 0x5d, 0x3a, 0xdc, 0x5b, 0x74, 0xe7, 0x6d, 0x57, 0x75, 0x78, 0x04, 0x25, 0x1d, 0xfc, 0x11,
-		0x54, 0xf1, 0x9b, 0x12, 0x0c, 0x2f, 0x3b, 0xf5, 0x4d, 0xa3, 0xf6, 0xff, 0xac, 0x8d, 0x6e, 0xc3,
-		0x91, 0x50, 0x0b, 0x6f, 0x91, 0x2a, 0x4f, 0x7f, 0x38, 0x01, 0xf1, 0x65, 0xa7, 0x8e, 0xde, 0x09,
-		0xa3, 0xad, 0x53, 0x7c, 0xd7, 0xc8, 0xad, 0xdd, 0xaf, 0x77, 0x5f, 0x5d, 0x75, 0x9f, 0x03, 0xd0,
-		0x2e, 0x0c, 0x87, 0xfd, 0xff, 0x89, 0x1e, 0x20, 0x21, 0xca, 0xc2, 0xc3, 0xfd, 0x52, 0x7a, 0x95,
-		0xfd, 0x14, 0xa4, 0x3d, 0x87, 0x76, 0x77, 0x0f, 0x6e, 0x41, 0xd4, 0x3d, 0x16, 0xed, 0xe0, 0x3a,
-		0x88, 0xf6, 0x5a, 0xdd, 0x46, 0x2f, 0xed, 0xb5, 0xd0, 0xf6, 0xd4, 0x5e, 0xb7, 0x21, 0xb5, 0x05,
-		0x10, 0xb0, 0xff, 0x7b, 0x7b, 0x20, 0xf8, 0x64, 0x85, 0x87, 0xfa, 0x22, 0xf3, 0x4e, 0x9c, 0x0e,
-		0x39, 0x74, 0xfe, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x62, 0x7a, 0xa4, 0xa8, 0x3d, 0x98, 0x00,
-		0x00,
+		// 10042 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x74, 0x24, 0xc7,
+		0x75, 0x1f, 0x8e, 0x9e, 0x19, 0x00, 0x33, 0x17, 0x03, 0x60, 0x50, 0xc0, 0x2e, 0x67, 0x67, 0x49,
+		0x00, 0xdb, 0x7c, 0x2d, 0x97, 0x22, 0x96, 0x5c, 0x72, 0x5f, 0xb3, 0x92, 0x28, 0x0c, 0x30, 0x8b,
+		0xc5, 0x2e, 0x5e, 0x6c, 0x00, 0xcb, 0x87, 0xec, 0xff, 0x9c, 0xc6, 0x4c, 0x61, 0xd0, 0x44, 0x4f,
+		0x77, 0xb3, 0xbb, 0x67, 0xb9, 0xa0, 0xac, 0xff, 0xa1, 0x2c, 0x47, 0x96, 0x98, 0x93, 0x58, 0x8e,
+		0x72, 0x62, 0x49, 0xd6, 0x2a, 0x94, 0x25, 0x47, 0x8e, 0x24, 0x27, 0x92, 0x45, 0xc9, 0x91, 0x9d,
+		0xe3, 0x48, 0x49, 0x1c, 0x4b, 0xfa, 0x90, 0x23, 0x39, 0x39, 0xb1, 0xe5, 0xd8, 0x94, 0x42, 0xe9,
+		0xd8, 0xb2, 0xa2, 0xc4, 0x8a, 0xa2, 0x1c, 0x27, 0xd1, 0x71, 0x4e, 0x4e, 0xbd, 0xfa, 0x31, 0xaf,
+		0x9e, 0xc1, 0x62, 0xe5, 0x75, 0xf8, 0x09, 0x53, 0x55, 0xf7, 0xfe, 0xea, 0xd6, 0xad, 0x5b, 0x55,
+		0xb7, 0x6e, 0x55, 0x35, 0xe0, 0x33, 0x17, 0x60, 0xba, 0x6a, 0x9a, 0x55, 0x1d, 0x9f, 0xb4, 0x6c,
+		0xd3, 0x35, 0xb7, 0xea, 0xdb, 0x27, 0x2b, 0xd8, 0x29, 0xdb, 0x9a, 0xe5, 0x9a, 0xf6, 0x0c, 0xcd,
+		0x43, 0xa3, 0x8c, 0x62, 0x46, 0x50, 0xc8, 0xcb, 0x30, 0x76, 0x51, 0xd3, 0xf1, 0xbc, 0x47, 0xb8,
+		0x8e, 0x5d, 0x74, 0x0e, 0x12, 0xdb, 0x9a, 0x8e, 0xb3, 0xd2, 0x74, 0xfc, 0xf8, 0xd0, 0xa9, 0x7b,
+		0x66, 0x1a, 0x98, 0x66, 0xc2, 0x1c, 0x6b, 0x24, 0x5b, 0xa1, 0x1c, 0xf2, 0x77, 0x12, 0x30, 0xde,
+		0xa2, 0x14, 0x21, 0x48, 0x18, 0x6a, 0x8d, 0x20, 0x4a, 0xc7, 0x53, 0x0a, 0xfd, 0x8d, 0xb2, 0x30,
+		0x68, 0xa9, 0xe5, 0x5d, 0xb5, 0x8a, 0xb3, 0x31, 0x9a, 0x2d, 0x92, 0x68, 0x12, 0xa0, 0x82, 0x2d,
+		0x6c, 0x54, 0xb0, 0x51, 0xde, 0xcb, 0xc6, 0xa7, 0xe3, 0xc7, 0x53, 0x4a, 0x20, 0x07, 0x3d, 0x08,
+		0x63, 0x56, 0x7d, 0x4b, 0xd7, 0xca, 0xa5, 0x00, 0x19, 0x4c, 0xc7, 0x8f, 0xf7, 0x2b, 0x19, 0x56,
+		0x30, 0xef, 0x13, 0xdf, 0x0f, 0xa3, 0xcf, 0x63, 0x75, 0x37, 0x48, 0x3a, 0x44, 0x49, 0x47, 0x48,
+		0x76, 0x80, 0x70, 0x0e, 0xd2, 0x35, 0xec, 0x38, 0x6a, 0x15, 0x97, 0xdc, 0x3d, 0x0b, 0x67, 0x13,
+		0xb4, 0xf5, 0xd3, 0x4d, 0xad, 0x6f, 0x6c, 0xf9, 0x10, 0xe7, 0xda, 0xd8, 0xb3, 0x30, 0x9a, 0x85,
+		0x14, 0x36, 0xea, 0x35, 0x86, 0xd0, 0xdf, 0x46, 0x7f, 0x45, 0xa3, 0x5e, 0x6b, 0x44, 0x49, 0x12,
+		0x36, 0x0e, 0x31, 0xe8, 0x60, 0xfb, 0x9a, 0x56, 0xc6, 0xd9, 0x01, 0x0a, 0x70, 0x7f, 0x13, 0xc0,
+		0x3a, 0x2b, 0x6f, 0xc4, 0x10, 0x7c, 0x68, 0x0e, 0x52, 0xf8, 0xba, 0x8b, 0x0d, 0x47, 0x33, 0x8d,
+		0xec, 0x20, 0x05, 0xb9, 0xb7, 0x45, 0x2f, 0x62, 0xbd, 0xd2, 0x08, 0xe1, 0xf3, 0xa1, 0x33, 0x30,
+		0x68, 0x5a, 0xae, 0x66, 0x1a, 0x4e, 0x36, 0x39, 0x2d, 0x1d, 0x1f, 0x3a, 0x75, 0x67, 0x4b, 0x43,
+		0x58, 0x65, 0x34, 0x8a, 0x20, 0x46, 0x8b, 0x90, 0x71, 0xcc, 0xba, 0x5d, 0xc6, 0xa5, 0xb2, 0x59,
+		0xc1, 0x25, 0xcd, 0xd8, 0x36, 0xb3, 0x29, 0x0a, 0x30, 0xd5, 0xdc, 0x10, 0x4a, 0x38, 0x67, 0x56,
+		0xf0, 0xa2, 0xb1, 0x6d, 0x2a, 0x23, 0x4e, 0x28, 0x8d, 0x0e, 0xc3, 0x80, 0xb3, 0x67, 0xb8, 0xea,
+		0xf5, 0x6c, 0x9a, 0x5a, 0x08, 0x4f, 0xc9, 0x5f, 0x18, 0x80, 0xd1, 0x6e, 0x4c, 0xec, 0x02, 0xf4,
+		0x6f, 0x93, 0x56, 0x66, 0x63, 0xbd, 0xe8, 0x80, 0xf1, 0x84, 0x95, 0x38, 0xb0, 0x4f, 0x25, 0xce,
+		0xc2, 0x90, 0x81, 0x1d, 0x17, 0x57, 0x98, 0x45, 0xc4, 0xbb, 0xb4, 0x29, 0x60, 0x4c, 0xcd, 0x26,
+		0x95, 0xd8, 0x97, 0x49, 0x3d, 0x05, 0xa3, 0x9e, 0x48, 0x25, 0x5b, 0x35, 0xaa, 0xc2, 0x36, 0x4f,
+		0x46, 0x49, 0x32, 0x53, 0x14, 0x7c, 0x0a, 0x61, 0x53, 0x46, 0x70, 0x28, 0x8d, 0xe6, 0x01, 0x4c,
+		0x03, 0x9b, 0xdb, 0xa5, 0x0a, 0x2e, 0xeb, 0xd9, 0x64, 0x1b, 0x2d, 0xad, 0x12, 0x92, 0x26, 0x2d,
+		0x99, 0x2c, 0xb7, 0xac, 0xa3, 0xf3, 0xbe, 0xa9, 0x0d, 0xb6, 0xb1, 0x94, 0x65, 0x36, 0xc8, 0x9a,
+		0xac, 0x6d, 0x13, 0x46, 0x6c, 0x4c, 0xec, 0x1e, 0x57, 0x78, 0xcb, 0x52, 0x54, 0x88, 0x99, 0xc8,
+		0x96, 0x29, 0x9c, 0x8d, 0x35, 0x6c, 0xd8, 0x0e, 0x26, 0xd1, 0xdd, 0xe0, 0x65, 0x94, 0xa8, 0x59,
+		0x01, 0x9d, 0x85, 0xd2, 0x22, 0x73, 0x45, 0xad, 0xe1, 0xdc, 0x0b, 0x30, 0x12, 0x56, 0x0f, 0x9a,
+		0x80, 0x7e, 0xc7, 0x55, 0x6d, 0x97, 0x5a, 0x61, 0xbf, 0xc2, 0x12, 0x28, 0x03, 0x71, 0x6c, 0x54,
+		0xe8, 0x2c, 0xd7, 0xaf, 0x90, 0x9f, 0xe8, 0x2d, 0x7e, 0x83, 0xe3, 0xb4, 0xc1, 0xf7, 0x35, 0xf7,
+		0x68, 0x08, 0xb9, 0xb1, 0xdd, 0xb9, 0xb3, 0x30, 0x1c, 0x6a, 0x40, 0xb7, 0x55, 0xcb, 0x3f, 0x03,
+		0x87, 0x5a, 0x42, 0xa3, 0xa7, 0x60, 0xa2, 0x6e, 0x68, 0x86, 0x8b, 0x6d, 0xcb, 0xc6, 0xc4, 0x62,
+		0x59, 0x55, 0xd9, 0x3f, 0x1b, 0x6c, 0x63, 0x73, 0x9b, 0x41, 0x6a, 0x86, 0xa2, 0x8c, 0xd7, 0x9b,
+		0x33, 0x4f, 0xa4, 0x92, 0xdf, 0x1d, 0xcc, 0xbc, 0xf8, 0xe2, 0x8b, 0x2f, 0xc6, 0xe4, 0x2f, 0x0d,
+		0xc0, 0x44, 0xab, 0x31, 0xd3, 0x72, 0xf8, 0x1e, 0x86, 0x01, 0xa3, 0x5e, 0xdb, 0xc2, 0x36, 0x55,
+		0x52, 0xbf, 0xc2, 0x53, 0x68, 0x16, 0xfa, 0x75, 0x75, 0x0b, 0xeb, 0xd9, 0xc4, 0xb4, 0x74, 0x7c,
+		0xe4, 0xd4, 0x83, 0x5d, 0x8d, 0xca, 0x99, 0x25, 0xc2, 0xa2, 0x30, 0x4e, 0xf4, 0x66, 0x48, 0xf0,
+		0x29, 0x9a, 0x20, 0x9c, 0xe8, 0x0e, 0x81, 0x8c, 0x25, 0x85, 0xf2, 0xa1, 0xa3, 0x90, 0x22, 0x7f,
+		0x99, 0x6d, 0x0c, 0x50, 0x99, 0x93, 0x24, 0x83, 0xd8, 0x05, 0xca, 0x41, 0x92, 0x0e, 0x93, 0x0a,
+		0x16, 0x4b, 0x9b, 0x97, 0x26, 0x86, 0x55, 0xc1, 0xdb, 0x6a, 0x5d, 0x77, 0x4b, 0xd7, 0x54, 0xbd,
+		0x8e, 0xa9, 0xc1, 0xa7, 0x94, 0x34, 0xcf, 0xbc, 0x4a, 0xf2, 0xd0, 0x14, 0x0c, 0xb1, 0x51, 0xa5,
+		0x19, 0x15, 0x7c, 0x9d, 0xce, 0x9e, 0xfd, 0x0a, 0x1b, 0x68, 0x8b, 0x24, 0x87, 0x54, 0xff, 0xac,
+		0x63, 0x1a, 0xc2, 0x34, 0x69, 0x15, 0x24, 0x83, 0x56, 0x7f, 0xb6, 0x71, 0xe2, 0xbe, 0xab, 0x75,
+		0xf3, 0x9a, 0xc6, 0xd2, 0xfd, 0x30, 0x4a, 0x29, 0x1e, 0xe5, 0x5d, 0xaf, 0xea, 0xd9, 0xb1, 0x69,
+		0xe9, 0x78, 0x52, 0x19, 0x61, 0xd9, 0xab, 0x3c, 0x57, 0xfe, 0x7c, 0x0c, 0x12, 0x74, 0x62, 0x19,
+		0x85, 0xa1, 0x8d, 0xa7, 0xd7, 0x8a, 0xa5, 0xf9, 0xd5, 0xcd, 0xc2, 0x52, 0x31, 0x23, 0xa1, 0x11,
+		0x00, 0x9a, 0x71, 0x71, 0x69, 0x75, 0x76, 0x23, 0x13, 0xf3, 0xd2, 0x8b, 0x2b, 0x1b, 0x67, 0x1e,
+		0xcb, 0xc4, 0x3d, 0x86, 0x4d, 0x96, 0x91, 0x08, 0x12, 0x3c, 0x7a, 0x2a, 0xd3, 0x8f, 0x32, 0x90,
+		0x66, 0x00, 0x8b, 0x4f, 0x15, 0xe7, 0xcf, 0x3c, 0x96, 0x19, 0x08, 0xe7, 0x3c, 0x7a, 0x2a, 0x33,
+		0x88, 0x86, 0x21, 0x45, 0x73, 0x0a, 0xab, 0xab, 0x4b, 0x99, 0xa4, 0x87, 0xb9, 0xbe, 0xa1, 0x2c,
+		0xae, 0x2c, 0x64, 0x52, 0x1e, 0xe6, 0x82, 0xb2, 0xba, 0xb9, 0x96, 0x01, 0x0f, 0x61, 0xb9, 0xb8,
+		0xbe, 0x3e, 0xbb, 0x50, 0xcc, 0x0c, 0x79, 0x14, 0x85, 0xa7, 0x37, 0x8a, 0xeb, 0x99, 0x74, 0x48,
+		0xac, 0x47, 0x4f, 0x65, 0x86, 0xbd, 0x2a, 0x8a, 0x2b, 0x9b, 0xcb, 0x99, 0x11, 0x34, 0x06, 0xc3,
+		0xac, 0x0a, 0x21, 0xc4, 0x68, 0x43, 0xd6, 0x99, 0xc7, 0x32, 0x19, 0x5f, 0x10, 0x86, 0x32, 0x16,
+		0xca, 0x38, 0xf3, 0x58, 0x06, 0xc9, 0x73, 0xd0, 0x4f, 0xcd, 0x10, 0x21, 0x18, 0x59, 0x9a, 0x2d,
+		0x14, 0x97, 0x4a, 0xab, 0x6b, 0x1b, 0x8b, 0xab, 0x2b, 0xb3, 0x4b, 0x19, 0xc9, 0xcf, 0x53, 0x8a,
+		0x4f, 0x6c, 0x2e, 0x2a, 0xc5, 0xf9, 0x4c, 0x2c, 0x98, 0xb7, 0x56, 0x9c, 0xdd, 0x28, 0xce, 0x67,
+		0xe2, 0x72, 0x19, 0x26, 0x5a, 0x4d, 0xa8, 0x2d, 0x87, 0x50, 0xc0, 0x16, 0x62, 0x6d, 0x6c, 0x81,
+		0x62, 0x35, 0xda, 0x82, 0xfc, 0xed, 0x18, 0x8c, 0xb7, 0x58, 0x54, 0x5a, 0x56, 0xf2, 0x38, 0xf4,
+		0x33, 0x5b, 0x66, 0xcb, 0xec, 0x03, 0x2d, 0x57, 0x27, 0x6a, 0xd9, 0x4d, 0x4b, 0x2d, 0xe5, 0x0b,
+		0xba, 0x1a, 0xf1, 0x36, 0xae, 0x06, 0x81, 0x68, 0x32, 0xd8, 0x9f, 0x6e, 0x9a, 0xfc, 0xd9, 0xfa,
+		0x78, 0xa6, 0x9b, 0xf5, 0x91, 0xe6, 0xf5, 0xb6, 0x08, 0xf4, 0xb7, 0x58, 0x04, 0x2e, 0xc0, 0x58,
+		0x13, 0x50, 0xd7, 0x93, 0xf1, 0x3b, 0x25, 0xc8, 0xb6, 0x53, 0x4e, 0xc4, 0x94, 0x18, 0x0b, 0x4d,
+		0x89, 0x17, 0x1a, 0x35, 0x78, 0xac, 0x7d, 0x27, 0x34, 0xf5, 0xf5, 0xc7, 0x25, 0x38, 0xdc, 0xda,
+		0xa5, 0x6c, 0x29, 0xc3, 0x9b, 0x61, 0xa0, 0x86, 0xdd, 0x1d, 0x53, 0xb8, 0x55, 0xf7, 0xb5, 0x58,
+		0xac, 0x49, 0x71, 0x63, 0x67, 0x73, 0x2e, 0x74, 0xbe, 0x51, 0xd6, 0xa9, 0x76, 0x0e, 0x6e, 0x93,
+		0xa4, 0xef, 0x89, 0xc1, 0xa1, 0x96, 0xe0, 0x2d, 0x05, 0xbd, 0x0b, 0x40, 0x33, 0xac, 0xba, 0xcb,
+		0x5c, 0x27, 0x36, 0x13, 0xa7, 0x68, 0x0e, 0x9d, 0xbc, 0xc8, 0x2c, 0x5b, 0x77, 0xbd, 0xf2, 0x38,
+		0x2d, 0x07, 0x96, 0x45, 0x09, 0xce, 0xf9, 0x82, 0x26, 0xa8, 0xa0, 0x93, 0x6d, 0x5a, 0xda, 0x64,
+		0x98, 0x0f, 0x43, 0xa6, 0xac, 0x6b, 0xd8, 0x70, 0x4b, 0x8e, 0x6b, 0x63, 0xb5, 0xa6, 0x19, 0x55,
+		0xba, 0xd4, 0x24, 0xf3, 0xfd, 0xdb, 0xaa, 0xee, 0x60, 0x65, 0x94, 0x15, 0xaf, 0x8b, 0x52, 0xc2,
+		0x41, 0x0d, 0xc8, 0x0e, 0x70, 0x0c, 0x84, 0x38, 0x58, 0xb1, 0xc7, 0x21, 0xff, 0x62, 0x0a, 0x86,
+		0x02, 0x0e, 0x38, 0x3a, 0x06, 0xe9, 0x67, 0xd5, 0x6b, 0x6a, 0x49, 0x6c, 0xaa, 0x98, 0x26, 0x86,
+		0x48, 0xde, 0x1a, 0xcb, 0x42, 0x0f, 0xc3, 0x04, 0x25, 0x31, 0xeb, 0x2e, 0xb6, 0x4b, 0x65, 0x5d,
+		0x75, 0x1c, 0xaa, 0xb4, 0x24, 0x25, 0x45, 0xa4, 0x6c, 0x95, 0x14, 0xcd, 0x89, 0x12, 0x74, 0x1a,
+		0xc6, 0x29, 0x47, 0xad, 0xae, 0xbb, 0x9a, 0xa5, 0xe3, 0x12, 0xd9, 0xe6, 0x39, 0x59, 0x08, 0x4a,
+		0x36, 0x46, 0x28, 0x96, 0x39, 0x01, 0x91, 0xc8, 0x41, 0xf3, 0x70, 0x17, 0x65, 0xab, 0x62, 0x03,
+		0xdb, 0xaa, 0x8b, 0x4b, 0xf8, 0xb9, 0xba, 0xaa, 0x3b, 0x25, 0xd5, 0xa8, 0x94, 0x76, 0x54, 0x67,
+		0x27, 0x3b, 0x41, 0x00, 0x0a, 0xb1, 0xac, 0xa4, 0x1c, 0x21, 0x84, 0x0b, 0x9c, 0xae, 0x48, 0xc9,
+		0x66, 0x8d, 0xca, 0x25, 0xd5, 0xd9, 0x41, 0x79, 0x38, 0x4c, 0x51, 0x1c, 0xd7, 0xd6, 0x8c, 0x6a,
+		0xa9, 0xbc, 0x83, 0xcb, 0xbb, 0xa5, 0xba, 0xbb, 0x7d, 0x2e, 0x7b, 0x34, 0x58, 0x3f, 0x95, 0x70,
+		0x9d, 0xd2, 0xcc, 0x11, 0x92, 0x4d, 0x77, 0xfb, 0x1c, 0x5a, 0x87, 0x34, 0xe9, 0x8c, 0x9a, 0xf6,
+		0x02, 0x2e, 0x6d, 0x9b, 0x36, 0x5d, 0x43, 0x47, 0x5a, 0x4c, 0x4d, 0x01, 0x0d, 0xce, 0xac, 0x72,
+		0x86, 0x65, 0xb3, 0x82, 0xf3, 0xfd, 0xeb, 0x6b, 0xc5, 0xe2, 0xbc, 0x32, 0x24, 0x50, 0x2e, 0x9a,
+		0x36, 0x31, 0xa8, 0xaa, 0xe9, 0x29, 0x78, 0x88, 0x19, 0x54, 0xd5, 0x14, 0xea, 0x3d, 0x0d, 0xe3,
+		0xe5, 0x32, 0x6b, 0xb3, 0x56, 0x2e, 0xf1, 0xcd, 0x98, 0x93, 0xcd, 0x84, 0x94, 0x55, 0x2e, 0x2f,
+		0x30, 0x02, 0x6e, 0xe3, 0x0e, 0x3a, 0x0f, 0x87, 0x7c, 0x65, 0x05, 0x19, 0xc7, 0x9a, 0x5a, 0xd9,
+		0xc8, 0x7a, 0x1a, 0xc6, 0xad, 0xbd, 0x66, 0x46, 0x14, 0xaa, 0xd1, 0xda, 0x6b, 0x64, 0x3b, 0x0b,
+		0x13, 0xd6, 0x8e, 0xd5, 0xcc, 0x77, 0x22, 0xc8, 0x87, 0xac, 0x1d, 0xab, 0x91, 0xf1, 0x5e, 0xba,
+		0x33, 0xb7, 0x71, 0x59, 0x75, 0x71, 0x25, 0x7b, 0x47, 0x90, 0x3c, 0x50, 0x80, 0x66, 0x20, 0x53,
+		0x2e, 0x97, 0xb0, 0xa1, 0x6e, 0xe9, 0xb8, 0xa4, 0xda, 0xd8, 0x50, 0x9d, 0xec, 0x14, 0x25, 0x4e,
+		0xb8, 0x76, 0x1d, 0x2b, 0x23, 0xe5, 0x72, 0x91, 0x16, 0xce, 0xd2, 0x32, 0x74, 0x02, 0xc6, 0xcc,
+		0xad, 0x67, 0xcb, 0xcc, 0x22, 0x4b, 0x96, 0x8d, 0xb7, 0xb5, 0xeb, 0xd9, 0x7b, 0xa8, 0x7a, 0x47,
+		0x49, 0x01, 0xb5, 0xc7, 0x35, 0x9a, 0x8d, 0x1e, 0x80, 0x4c, 0xd9, 0xd9, 0x51, 0x6d, 0x8b, 0x4e,
+		0xc9, 0x8e, 0xa5, 0x96, 0x71, 0xf6, 0x5e, 0x46, 0xca, 0xf2, 0x57, 0x44, 0x36, 0x19, 0x11, 0xce,
+		0xf3, 0xda, 0xb6, 0x2b, 0x10, 0xef, 0x67, 0x23, 0x82, 0xe6, 0x71, 0xb4, 0xe3, 0x90, 0x21, 0x9a,
+		0x08, 0x55, 0x7c, 0x9c, 0x92, 0x8d, 0x58, 0x3b, 0x56, 0xb0, 0xde, 0xbb, 0x61, 0xd8, 0xda, 0x09,
+		0x56, 0xfa, 0x00, 0x73, 0xdc, 0xac, 0x9d, 0x40, 0x8d, 0x8f, 0xc1, 0x61, 0x42, 0x54, 0xc3, 0xae,
+		0x5a, 0x51, 0x5d, 0x35, 0x40, 0xfd, 0x06, 0x4a, 0x4d, 0xd4, 0xbe, 0xcc, 0x0b, 0x43, 0x72, 0xda,
+		0xf5, 0xad, 0x3d, 0xcf, 0xb0, 0x1e, 0x62, 0x72, 0x92, 0x3c, 0x61, 0x5a, 0xb7, 0xcc, 0x39, 0x97,
+		0xf3, 0x90, 0x0e, 0xda, 0x3d, 0x4a, 0x01, 0xb3, 0xfc, 0x8c, 0x44, 0x9c, 0xa0, 0xb9, 0xd5, 0x79,
+		0xe2, 0xbe, 0x3c, 0x53, 0xcc, 0xc4, 0x88, 0x1b, 0xb5, 0xb4, 0xb8, 0x51, 0x2c, 0x29, 0x9b, 0x2b,
+		0x1b, 0x8b, 0xcb, 0xc5, 0x4c, 0x3c, 0xe0, 0xd8, 0x5f, 0x4e, 0x24, 0xef, 0xcb, 0xdc, 0x2f, 0x7f,
+		0x3d, 0x06, 0x23, 0xe1, 0x9d, 0x1a, 0x7a, 0x23, 0xdc, 0x21, 0xc2, 0x2a, 0x0e, 0x76, 0x4b, 0xcf,
+		0x6b, 0x36, 0x1d, 0x90, 0x35, 0x95, 0x2d, 0x8e, 0x9e, 0xfd, 0x4c, 0x70, 0xaa, 0x75, 0xec, 0x3e,
+		0xa9, 0xd9, 0x64, 0xb8, 0xd5, 0x54, 0x17, 0x2d, 0xc1, 0x94, 0x61, 0x96, 0x1c, 0x57, 0x35, 0x2a,
+		0xaa, 0x5d, 0x29, 0xf9, 0x01, 0xad, 0x92, 0x5a, 0x2e, 0x63, 0xc7, 0x31, 0xd9, 0x42, 0xe8, 0xa1,
+		0xdc, 0x69, 0x98, 0xeb, 0x9c, 0xd8, 0x5f, 0x21, 0x66, 0x39, 0x69, 0x83, 0xf9, 0xc6, 0xdb, 0x99,
+		0xef, 0x51, 0x48, 0xd5, 0x54, 0xab, 0x84, 0x0d, 0xd7, 0xde, 0xa3, 0xfe, 0x79, 0x52, 0x49, 0xd6,
+		0x54, 0xab, 0x48, 0xd2, 0x3f, 0x91, 0x6d, 0xd2, 0xe5, 0x44, 0x32, 0x99, 0x49, 0x5d, 0x4e, 0x24,
+		0x53, 0x19, 0x90, 0x5f, 0x8b, 0x43, 0x3a, 0xe8, 0xaf, 0x93, 0xed, 0x4f, 0x99, 0xae, 0x58, 0x12,
+		0x9d, 0xd3, 0xee, 0xee, 0xe8, 0xdd, 0xcf, 0xcc, 0x91, 0xa5, 0x2c, 0x3f, 0xc0, 0x9c, 0x63, 0x85,
+		0x71, 0x12, 0x37, 0x82, 0x18, 0x1b, 0x66, 0xce, 0x48, 0x52, 0xe1, 0x29, 0xb4, 0x00, 0x03, 0xcf,
+		0x3a, 0x14, 0x7b, 0x80, 0x62, 0xdf, 0xd3, 0x19, 0xfb, 0xf2, 0x3a, 0x05, 0x4f, 0x5d, 0x5e, 0x2f,
+		0xad, 0xac, 0x2a, 0xcb, 0xb3, 0x4b, 0x0a, 0x67, 0x47, 0x47, 0x20, 0xa1, 0xab, 0x2f, 0xec, 0x85,
+		0x17, 0x3d, 0x9a, 0xd5, 0x6d, 0x27, 0x1c, 0x81, 0x04, 0x09, 0xd0, 0x85, 0x97, 0x1a, 0x9a, 0x75,
+		0x0b, 0x07, 0xc3, 0x49, 0xe8, 0xa7, 0xfa, 0x42, 0x00, 0x5c, 0x63, 0x99, 0x3e, 0x94, 0x84, 0xc4,
+		0xdc, 0xaa, 0x42, 0x06, 0x44, 0x06, 0xd2, 0x2c, 0xb7, 0xb4, 0xb6, 0x58, 0x9c, 0x2b, 0x66, 0x62,
+		0xf2, 0x69, 0x18, 0x60, 0x4a, 0x20, 0x83, 0xc5, 0x53, 0x43, 0xa6, 0x8f, 0x27, 0x39, 0x86, 0x24,
+		0x4a, 0x37, 0x97, 0x0b, 0x45, 0x25, 0x13, 0x0b, 0x77, 0x75, 0x22, 0xd3, 0x2f, 0x3b, 0x90, 0x0e,
+		0xfa, 0xe1, 0x3f, 0x99, 0xcd, 0xf8, 0x17, 0x25, 0x18, 0x0a, 0xf8, 0xd5, 0xc4, 0x21, 0x52, 0x75,
+		0xdd, 0x7c, 0xbe, 0xa4, 0xea, 0x9a, 0xea, 0x70, 0xd3, 0x00, 0x9a, 0x35, 0x4b, 0x72, 0xba, 0xed,
+		0xba, 0x9f, 0xd0, 0x10, 0xe9, 0xcf, 0x0c, 0xc8, 0x1f, 0x96, 0x20, 0xd3, 0xe8, 0xd8, 0x36, 0x88,
+		0x29, 0xfd, 0x75, 0x8a, 0x29, 0x7f, 0x48, 0x82, 0x91, 0xb0, 0x37, 0xdb, 0x20, 0xde, 0xb1, 0xbf,
+		0x56, 0xf1, 0xbe, 0x15, 0x83, 0xe1, 0x90, 0x0f, 0xdb, 0xad, 0x74, 0xcf, 0xc1, 0x98, 0x56, 0xc1,
+		0x35, 0xcb, 0x74, 0x49, 0xf0, 0xbc, 0xa4, 0xe3, 0x6b, 0x58, 0xcf, 0xca, 0x74, 0xd2, 0x38, 0xd9,
+		0xd9, 0x4b, 0x9e, 0x59, 0xf4, 0xf9, 0x96, 0x08, 0x5b, 0x7e, 0x7c, 0x71, 0xbe, 0xb8, 0xbc, 0xb6,
+		0xba, 0x51, 0x5c, 0x99, 0x7b, 0xba, 0xb4, 0xb9, 0x72, 0x65, 0x65, 0xf5, 0xc9, 0x15, 0x25, 0xa3,
+		0x35, 0x90, 0xdd, 0xc2, 0x61, 0xbf, 0x06, 0x99, 0x46, 0xa1, 0xd0, 0x1d, 0xd0, 0x4a, 0xac, 0x4c,
+		0x1f, 0x1a, 0x87, 0xd1, 0x95, 0xd5, 0xd2, 0xfa, 0xe2, 0x7c, 0xb1, 0x54, 0xbc, 0x78, 0xb1, 0x38,
+		0xb7, 0xb1, 0xce, 0xe2, 0x1e, 0x1e, 0xf5, 0x46, 0x68, 0x80, 0xcb, 0x1f, 0x8c, 0xc3, 0x78, 0x0b,
+		0x49, 0xd0, 0x2c, 0xdf, 0xb1, 0xb0, 0x4d, 0xd4, 0x43, 0xdd, 0x48, 0x3f, 0x43, 0x7c, 0x86, 0x35,
+		0xd5, 0x76, 0xf9, 0x06, 0xe7, 0x01, 0x20, 0x5a, 0x32, 0x5c, 0x6d, 0x5b, 0xc3, 0x36, 0x8f, 0x27,
+		0xb1, 0x6d, 0xcc, 0xa8, 0x9f, 0xcf, 0x42, 0x4a, 0x6f, 0x00, 0x64, 0x99, 0x8e, 0xe6, 0x6a, 0xd7,
+		0x48, 0x48, 0x5e, 0x04, 0x9f, 0xc8, 0xb6, 0x26, 0xa1, 0x64, 0x44, 0xc9, 0xa2, 0xe1, 0x7a, 0xd4,
+		0x06, 0xae, 0xaa, 0x0d, 0xd4, 0x64, 0x32, 0x8f, 0x2b, 0x19, 0x51, 0xe2, 0x51, 0x1f, 0x83, 0x74,
+		0xc5, 0xac, 0x13, 0x5f, 0x8f, 0xd1, 0x91, 0xb5, 0x43, 0x52, 0x86, 0x58, 0x9e, 0x47, 0xc2, 0xbd,
+		0x78, 0x3f, 0xea, 0x95, 0x56, 0x86, 0x58, 0x1e, 0x23, 0xb9, 0x1f, 0x46, 0xd5, 0x6a, 0xd5, 0x26,
+		0xe0, 0x02, 0x88, 0xed, 0x4b, 0x46, 0xbc, 0x6c, 0x4a, 0x98, 0xbb, 0x0c, 0x49, 0xa1, 0x07, 0xb2,
+		0x54, 0x13, 0x4d, 0x94, 0x2c, 0xb6, 0xd9, 0x8e, 0x91, 0x40, 0x98, 0x21, 0x0a, 0x8f, 0x41, 0x5a,
+		0x73, 0x4a, 0x7e, 0x10, 0x3f, 0x36, 0x1d, 0x3b, 0x9e, 0x54, 0x86, 0x34, 0xc7, 0x0b, 0x80, 0xca,
+		0x1f, 0x8f, 0xc1, 0x48, 0xf8, 0x10, 0x02, 0xcd, 0x43, 0x52, 0x37, 0xcb, 0x2a, 0x35, 0x2d, 0x76,
+		0x02, 0x76, 0x3c, 0xe2, 0xdc, 0x62, 0x66, 0x89, 0xd3, 0x2b, 0x1e, 0x67, 0xee, 0xdf, 0x4a, 0x90,
+		0x14, 0xd9, 0xe8, 0x30, 0x24, 0x2c, 0xd5, 0xdd, 0xa1, 0x70, 0xfd, 0x85, 0x58, 0x46, 0x52, 0x68,
+		0x9a, 0xe4, 0x3b, 0x96, 0x6a, 0x64, 0x63, 0x7e, 0x3e, 0x49, 0x93, 0x7e, 0xd5, 0xb1, 0x5a, 0xa1,
+		0x9b, 0x1e, 0xb3, 0x56, 0xc3, 0x86, 0xeb, 0x88, 0x7e, 0xe5, 0xf9, 0x73, 0x3c, 0x9b, 0x9c, 0x85,
+		0xb9, 0xb6, 0xaa, 0xe9, 0x21, 0xda, 0x04, 0xa5, 0xcd, 0x88, 0x02, 0x8f, 0x38, 0x0f, 0x47, 0x04,
+		0x6e, 0x05, 0xbb, 0x6a, 0x79, 0x07, 0x57, 0x7c, 0xa6, 0x01, 0x1a, 0xdc, 0xb8, 0x83, 0x13, 0xcc,
+		0xf3, 0x72, 0xc1, 0x2b, 0x7f, 0x5d, 0x82, 0x31, 0xb1, 0x4d, 0xab, 0x78, 0xca, 0x5a, 0x06, 0x50,
+		0x0d, 0xc3, 0x74, 0x83, 0xea, 0x6a, 0x36, 0xe5, 0x26, 0xbe, 0x99, 0x59, 0x8f, 0x49, 0x09, 0x00,
+		0xe4, 0x6a, 0x00, 0x7e, 0x49, 0x5b, 0xb5, 0x4d, 0xc1, 0x10, 0x3f, 0x61, 0xa2, 0xc7, 0x94, 0x6c,
+		0x63, 0x0f, 0x2c, 0x8b, 0xec, 0xe7, 0x48, 0xf8, 0x65, 0x0b, 0x57, 0x35, 0x83, 0xc7, 0x8d, 0x59,
+		0x42, 0x84, 0x5f, 0x12, 0x5e, 0xf8, 0xa5, 0xf0, 0xff, 0xc3, 0x78, 0xd9, 0xac, 0x35, 0x8a, 0x5b,
+		0xc8, 0x34, 0x04, 0x17, 0x9c, 0x4b, 0xd2, 0x33, 0x0f, 0x71, 0xa2, 0xaa, 0xa9, 0xab, 0x46, 0x75,
+		0xc6, 0xb4, 0xab, 0xfe, 0x31, 0x2b, 0xf1, 0x78, 0x9c, 0xc0, 0x61, 0xab, 0xb5, 0xf5, 0x3f, 0x25,
+		0xe9, 0x57, 0x62, 0xf1, 0x85, 0xb5, 0xc2, 0x27, 0x62, 0xb9, 0x05, 0xc6, 0xb8, 0x26, 0x94, 0xa1,
+		0xe0, 0x6d, 0x1d, 0x97, 0x49, 0x03, 0xe1, 0x7b, 0x0f, 0xc2, 0x44, 0xd5, 0xac, 0x9a, 0x14, 0xe9,
+		0x24, 0xf9, 0xc5, 0xcf, 0x69, 0x53, 0x5e, 0x6e, 0x2e, 0xf2, 0x50, 0x37, 0xbf, 0x02, 0xe3, 0x9c,
+		0xb8, 0x44, 0x0f, 0x8a, 0xd8, 0x36, 0x06, 0x75, 0x8c, 0xa1, 0x65, 0x3f, 0xf3, 0x1d, 0xba, 0x7c,
+		0x2b, 0x63, 0x9c, 0x95, 0x94, 0xb1, 0x9d, 0x4e, 0x5e, 0x81, 0x43, 0x21, 0x3c, 0x36, 0x48, 0xb1,
+		0x1d, 0x81, 0xf8, 0xbb, 0x1c, 0x71, 0x3c, 0x80, 0xb8, 0xce, 0x59, 0xf3, 0x73, 0x30, 0xdc, 0x0b,
+		0xd6, 0xbf, 0xe1, 0x58, 0x69, 0x1c, 0x04, 0x59, 0x80, 0x51, 0x0a, 0x52, 0xae, 0x3b, 0xae, 0x59,
+		0xa3, 0x33, 0x60, 0x67, 0x98, 0xdf, 0xfb, 0x0e, 0x1b, 0x35, 0x23, 0x84, 0x6d, 0xce, 0xe3, 0xca,
+		0xe7, 0x81, 0x9e, 0x8d, 0x91, 0x33, 0xab, 0x08, 0x84, 0x2f, 0x73, 0x41, 0x3c, 0xfa, 0xfc, 0x55,
+		0x98, 0x20, 0xbf, 0xe9, 0x04, 0x15, 0x94, 0x24, 0x3a, 0xe0, 0x96, 0xfd, 0xfa, 0x3b, 0xd9, 0xc0,
+		0x1c, 0xf7, 0x00, 0x02, 0x32, 0x05, 0x7a, 0xb1, 0x8a, 0x5d, 0x17, 0xdb, 0x4e, 0x49, 0xd5, 0x5b,
+		0x89, 0x17, 0x88, 0x58, 0x64, 0x3f, 0xf0, 0xfd, 0x70, 0x2f, 0x2e, 0x30, 0xce, 0x59, 0x5d, 0xcf,
+		0x6f, 0xc2, 0x1d, 0x2d, 0xac, 0xa2, 0x0b, 0xcc, 0x0f, 0x72, 0xcc, 0x89, 0x26, 0xcb, 0x20, 0xb0,
+		0x6b, 0x20, 0xf2, 0xbd, 0xbe, 0xec, 0x02, 0xf3, 0x97, 0x39, 0x26, 0xe2, 0xbc, 0xa2, 0x4b, 0x09,
+		0xe2, 0x65, 0x18, 0xbb, 0x86, 0xed, 0x2d, 0xd3, 0xe1, 0x51, 0xa2, 0x2e, 0xe0, 0x3e, 0xc4, 0xe1,
+		0x46, 0x39, 0x23, 0x0d, 0x1b, 0x11, 0xac, 0xf3, 0x90, 0xdc, 0x56, 0xcb, 0xb8, 0x0b, 0x88, 0x1b,
+		0x1c, 0x62, 0x90, 0xd0, 0x13, 0xd6, 0x59, 0x48, 0x57, 0x4d, 0xbe, 0x46, 0x45, 0xb3, 0x7f, 0x98,
+		0xb3, 0x0f, 0x09, 0x1e, 0x0e, 0x61, 0x99, 0x56, 0x5d, 0x27, 0x0b, 0x58, 0x34, 0xc4, 0x3f, 0x14,
+		0x10, 0x82, 0x87, 0x43, 0xf4, 0xa0, 0xd6, 0x97, 0x05, 0x84, 0x13, 0xd0, 0xe7, 0xe3, 0xe4, 0xf0,
+		0x48, 0xdf, 0x33, 0x8d, 0x6e, 0x84, 0xf8, 0x08, 0x47, 0x00, 0xce, 0x42, 0x00, 0x2e, 0x40, 0xaa,
+		0xdb, 0x8e, 0xf8, 0xd5, 0xef, 0x8b, 0xe1, 0x21, 0x7a, 0x60, 0x01, 0x46, 0xc5, 0x04, 0x45, 0x0e,
+		0x9b, 0xa3, 0x21, 0xfe, 0x11, 0x87, 0x18, 0x09, 0xb0, 0xf1, 0x66, 0xb8, 0xd8, 0x71, 0xab, 0xb8,
+		0x1b, 0x90, 0x8f, 0x8b, 0x66, 0x70, 0x16, 0xae, 0xca, 0x2d, 0x6c, 0x94, 0x77, 0xba, 0x43, 0xf8,
+		0x35, 0xa1, 0x4a, 0xc1, 0x43, 0x20, 0xe6, 0x60, 0xb8, 0xa6, 0xda, 0xce, 0x8e, 0xaa, 0x77, 0xd5,
+		0x1d, 0xff, 0x98, 0x63, 0xa4, 0x3d, 0x26, 0xae, 0x91, 0xba, 0xd1, 0x0b, 0xcc, 0x27, 0x84, 0x46,
+		0xea, 0x46, 0x08, 0x68, 0x0d, 0x26, 0x1c, 0x97, 0x86, 0xd4, 0x7a, 0x41, 0xfb, 0xa4, 0x18, 0x7a,
+		0x8c, 0x77, 0x39, 0x88, 0x78, 0x01, 0x52, 0x8e, 0xf6, 0x42, 0x57, 0x30, 0x9f, 0x12, 0x3d, 0x4d,
+		0x19, 0x08, 0xf3, 0xd3, 0x70, 0xa4, 0xe5, 0x32, 0xd1, 0x05, 0xd8, 0xaf, 0x73, 0xb0, 0xc3, 0x2d,
+		0x96, 0x0a, 0x3e, 0x25, 0xf4, 0x0a, 0xf9, 0x4f, 0xc4, 0x94, 0x80, 0x1b, 0xb0, 0xd6, 0xc8, 0xae,
+		0xc1, 0x51, 0xb7, 0x7b, 0xd3, 0xda, 0x3f, 0x15, 0x5a, 0x63, 0xbc, 0x21, 0xad, 0x6d, 0xc0, 0x61,
+		0x8e, 0xd8, 0x5b, 0xbf, 0x7e, 0x5a, 0x4c, 0xac, 0x8c, 0x7b, 0x33, 0xdc, 0xbb, 0x6f, 0x85, 0x9c,
+		0xa7, 0x4e, 0xe1, 0x9e, 0x3a, 0x25, 0x12, 0x87, 0x8a, 0x46, 0xfe, 0x0c, 0x47, 0x16, 0x33, 0xbe,
+		0xe7, 0xdf, 0x3a, 0xcb, 0xaa, 0x45, 0xc0, 0x9f, 0x82, 0xac, 0x00, 0xaf, 0x1b, 0x36, 0x2e, 0x9b,
+		0x55, 0x43, 0x7b, 0x01, 0x57, 0xba, 0x80, 0xfe, 0x8d, 0x86, 0xae, 0xda, 0x0c, 0xb0, 0x13, 0xe4,
+		0x45, 0xc8, 0x78, 0xbe, 0x4a, 0x49, 0xab, 0x59, 0xa6, 0xed, 0x46, 0x20, 0x7e, 0x56, 0xf4, 0x94,
+		0xc7, 0xb7, 0x48, 0xd9, 0xf2, 0x45, 0x60, 0xe7, 0xcc, 0xdd, 0x9a, 0xe4, 0x2b, 0x1c, 0x68, 0xd8,
+		0xe7, 0xe2, 0x13, 0x47, 0xd9, 0xac, 0x59, 0xaa, 0xdd, 0xcd, 0xfc, 0xf7, 0x39, 0x31, 0x71, 0x70,
+		0x16, 0x3e, 0x71, 0x10, 0x8f, 0x8e, 0xac, 0xf6, 0x5d, 0x20, 0x7c, 0x5e, 0x4c, 0x1c, 0x82, 0x87,
+		0x43, 0x08, 0x87, 0xa1, 0x0b, 0x88, 0xdf, 0x14, 0x10, 0x82, 0x87, 0x40, 0x3c, 0xe1, 0x2f, 0xb4,
+		0x36, 0xae, 0x6a, 0x8e, 0x6b, 0x33, 0xa7, 0xb8, 0x33, 0xd4, 0x3f, 0xfb, 0x7e, 0xd8, 0x09, 0x53,
+		0x02, 0xac, 0x64, 0x26, 0xe2, 0x41, 0x56, 0xba, 0x67, 0x8a, 0x16, 0xec, 0x0b, 0x62, 0x26, 0x0a,
+		0xb0, 0x11, 0xd9, 0x02, 0x1e, 0x22, 0x51, 0x7b, 0x99, 0xec, 0x14, 0xba, 0x80, 0xfb, 0xad, 0x06,
+		0xe1, 0xd6, 0x05, 0x2f, 0xc1, 0x0c, 0xf8, 0x3f, 0x75, 0x63, 0x17, 0xef, 0x75, 0x65, 0x9d, 0xbf,
+		0xdd, 0xe0, 0xff, 0x6c, 0x32, 0x4e, 0x36, 0x87, 0x8c, 0x36, 0xf8, 0x53, 0x28, 0xea, 0x56, 0x51,
+		0xf6, 0x1d, 0x3f, 0xe2, 0xed, 0x0d, 0xbb, 0x53, 0xf9, 0x25, 0xc8, 0xf0, 0x1c, 0xdf, 0x81, 0x8d,
+		0x04, 0x7b, 0xe7, 0x8f, 0x3c, 0x3b, 0x0f, 0xf9, 0x3c, 0xf9, 0x8b, 0x30, 0x1c, 0x72, 0x78, 0xa2,
+		0xa1, 0x7e, 0x8e, 0x43, 0xa5, 0x83, 0xfe, 0x4e, 0xfe, 0x34, 0x24, 0x88, 0xf3, 0x12, 0xcd, 0xfe,
+		0xb7, 0x38, 0x3b, 0x25, 0xcf, 0xbf, 0x09, 0x92, 0xc2, 0x69, 0x89, 0x66, 0x7d, 0x17, 0x67, 0xf5,
+		0x58, 0x08, 0xbb, 0x70, 0x58, 0xa2, 0xd9, 0x7f, 0x5e, 0xb0, 0x0b, 0x16, 0xc2, 0xde, 0xbd, 0x0a,
+		0xbf, 0xf8, 0xb7, 0x13, 0x8c, 0x5d, 0xb0, 0xe4, 0xc9, 0x39, 0x37, 0xf3, 0x54, 0xa2, 0xb9, 0xdf,
+		0xc3, 0x2b, 0x17, 0x1c, 0xf9, 0xb3, 0xd0, 0xdf, 0xa5, 0xc2, 0xff, 0x0e, 0x67, 0x65, 0xf4, 0xf9,
+		0x39, 0x18, 0x0a, 0x78, 0x27, 0xd1, 0xec, 0x7f, 0x97, 0xb3, 0x07, 0xb9, 0x88, 0xe8, 0xdc, 0x3b,
+		0x89, 0x06, 0xf8, 0x05, 0x21, 0x3a, 0xe7, 0x20, 0x6a, 0x13, 0x8e, 0x49, 0x34, 0xf7, 0x7b, 0x85,
+		0xd6, 0x05, 0x4b, 0xfe, 0x71, 0x48, 0x79, 0x8b, 0x4d, 0x34, 0xff, 0x2f, 0x72, 0x7e, 0x9f, 0x87,
+		0x68, 0xa0, 0x6e, 0xf4, 0x00, 0xf1, 0xf7, 0x84, 0x06, 0x02, 0x5c, 0x64, 0x18, 0x35, 0x3a, 0x30,
+		0xd1, 0x48, 0xef, 0x13, 0xc3, 0xa8, 0xc1, 0x7f, 0x21, 0xbd, 0x49, 0xe7, 0xfc, 0x68, 0x88, 0xbf,
+		0x2f, 0x7a, 0x93, 0xd2, 0x13, 0x31, 0x1a, 0x3d, 0x82, 0x68, 0x8c, 0x5f, 0x12, 0x62, 0x34, 0x38,
+		0x04, 0xf9, 0x35, 0x40, 0xcd, 0xde, 0x40, 0x34, 0xde, 0xfb, 0x39, 0xde, 0x58, 0x93, 0x33, 0x90,
+		0x7f, 0x12, 0x0e, 0xb7, 0xf6, 0x04, 0xa2, 0x51, 0x3f, 0xf0, 0xa3, 0x86, 0xbd, 0x5b, 0xd0, 0x11,
+		0xc8, 0x6f, 0xc0, 0x44, 0x2b, 0x2f, 0x20, 0x1a, 0xf6, 0x83, 0x3f, 0x0a, 0x4f, 0xdc, 0x41, 0x27,
+		0x20, 0x3f, 0x0b, 0xe0, 0x2f, 0xc0, 0xd1, 0x58, 0x1f, 0xe2, 0x58, 0x01, 0x26, 0x32, 0x34, 0xf8,
+		0xfa, 0x1b, 0xcd, 0x7f, 0x43, 0x0c, 0x0d, 0xce, 0x41, 0x86, 0x86, 0x58, 0x7a, 0xa3, 0xb9, 0x3f,
+		0x2c, 0x86, 0x86, 0x60, 0x21, 0x96, 0x1d, 0x58, 0xdd, 0xa2, 0x11, 0x3e, 0x22, 0x2c, 0x3b, 0xc0,
+		0x95, 0x5f, 0x81, 0xb1, 0xa6, 0x05, 0x31, 0x1a, 0xea, 0x57, 0x38, 0x54, 0xa6, 0x71, 0x3d, 0x0c,
+		0x2e, 0x5e, 0x7c, 0x31, 0x8c, 0x46, 0xfb, 0x68, 0xc3, 0xe2, 0xc5, 0xd7, 0xc2, 0xfc, 0x05, 0x48,
+		0x1a, 0x75, 0x5d, 0x27, 0x83, 0x07, 0x75, 0xbe, 0x09, 0x98, 0xfd, 0xf3, 0x1f, 0x73, 0xed, 0x08,
+		0x86, 0xfc, 0x69, 0xe8, 0xc7, 0xb5, 0x2d, 0x5c, 0x89, 0xe2, 0xfc, 0xde, 0x8f, 0xc5, 0x84, 0x49,
+		0xa8, 0xf3, 0x8f, 0x03, 0xb0, 0xd0, 0x08, 0x3d, 0x0c, 0x8c, 0xe0, 0xfd, 0xcf, 0x3f, 0xe6, 0x57,
+		0x6f, 0x7c, 0x16, 0x1f, 0x80, 0x5d, 0xe4, 0xe9, 0x0c, 0xf0, 0xfd, 0x30, 0x00, 0xed, 0x91, 0xf3,
+		0x30, 0x48, 0x2e, 0x44, 0xba, 0x6a, 0x35, 0x8a, 0xfb, 0xbf, 0x70, 0x6e, 0x41, 0x4f, 0x14, 0x56,
+		0x33, 0x6d, 0xec, 0xaa, 0x55, 0x27, 0x8a, 0xf7, 0xbf, 0x72, 0x5e, 0x8f, 0x81, 0x30, 0x97, 0x55,
+		0xc7, 0xed, 0xa6, 0xdd, 0x7f, 0x21, 0x98, 0x05, 0x03, 0x11, 0x9a, 0xfc, 0xde, 0xc5, 0x7b, 0x51,
+		0xbc, 0x3f, 0x10, 0x42, 0x73, 0xfa, 0xfc, 0x9b, 0x20, 0x45, 0x7e, 0xb2, 0xfb, 0x74, 0x11, 0xcc,
+		0xff, 0x8d, 0x33, 0xfb, 0x1c, 0xa4, 0x66, 0xc7, 0xad, 0xb8, 0x5a, 0xb4, 0xb2, 0x7f, 0xc8, 0x7b,
+		0x5a, 0xd0, 0xe7, 0x67, 0x61, 0xc8, 0x71, 0x2b, 0x95, 0x3a, 0xf7, 0x4f, 0x23, 0xd8, 0xff, 0xfb,
+		0x8f, 0xbd, 0x90, 0x85, 0xc7, 0x43, 0x7a, 0xfb, 0xf9, 0x5d, 0xd7, 0x32, 0xe9, 0x81, 0x47, 0x14,
+		0xc2, 0x8f, 0x38, 0x42, 0x80, 0x25, 0x3f, 0x07, 0x69, 0xd2, 0x16, 0x1b, 0x5b, 0x98, 0x9e, 0x4e,
+		0x45, 0x40, 0xfc, 0x0f, 0xae, 0x80, 0x10, 0x53, 0xe1, 0xa7, 0xbf, 0xfc, 0xda, 0xa4, 0xf4, 0xb5,
+		0xd7, 0x26, 0xa5, 0x6f, 0xbd, 0x36, 0x29, 0xbd, 0xf7, 0xdb, 0x93, 0x7d, 0x5f, 0xfb, 0xf6, 0x64,
+		0xdf, 0x1f, 0x7e, 0x7b, 0xb2, 0xaf, 0x75, 0x94, 0x18, 0x16, 0xcc, 0x05, 0x93, 0xc5, 0x87, 0x9f,
+		0x91, 0xab, 0x9a, 0xbb, 0x53, 0xdf, 0x9a, 0x29, 0x9b, 0x35, 0x1a, 0xc6, 0xf5, 0xa3, 0xb5, 0xde,
+		0x26, 0x07, 0xbe, 0x17, 0x83, 0x23, 0x65, 0xd3, 0xa9, 0x99, 0x4e, 0x89, 0xc5, 0x7b, 0x59, 0x82,
+		0x01, 0xa2, 0x74, 0xb0, 0xa8, 0x8b, 0xa0, 0xef, 0x06, 0x4c, 0x68, 0x35, 0x4b, 0xc7, 0x34, 0x38,
+		0x5f, 0xa2, 0x5a, 0xe8, 0xce, 0x19, 0xfc, 0xca, 0x7f, 0xe8, 0x67, 0x41, 0x48, 0x9f, 0x7d, 0x51,
+		0x70, 0xe7, 0x97, 0x60, 0x8c, 0xdc, 0xab, 0xb0, 0x42, 0x90, 0x11, 0xca, 0x14, 0x80, 0x19, 0xce,
+		0xe9, 0xa3, 0x9d, 0x85, 0x01, 0xa7, 0xac, 0xea, 0x6a, 0x64, 0x97, 0x7e, 0x95, 0x43, 0x70, 0xf2,
+		0xc2, 0xb9, 0x76, 0x3d, 0xf1, 0xcc, 0x64, 0x40, 0xd1, 0x4c, 0x63, 0xfc, 0xcf, 0x43, 0x0c, 0x79,
+		0x80, 0xfe, 0x79, 0x14, 0xfe, 0x20, 0x0e, 0x93, 0xbc, 0x7c, 0x4b, 0x75, 0xf0, 0xc9, 0x6b, 0x8f,
+		0x6c, 0x61, 0x57, 0x7d, 0xe4, 0x64, 0xd9, 0xd4, 0x0c, 0xae, 0xf1, 0x71, 0xae, 0x7f, 0x52, 0x3e,
+		0xc3, 0xcb, 0x73, 0x2d, 0xc3, 0xf1, 0xb9, 0xf6, 0xfd, 0x26, 0x6f, 0x42, 0x62, 0xce, 0xd4, 0x0c,
+		0x72, 0xe4, 0x50, 0xc1, 0x86, 0x59, 0xe3, 0xd7, 0xee, 0x58, 0x02, 0x3d, 0x02, 0x03, 0x6a, 0xcd,
+		0xac, 0x1b, 0x2e, 0x3b, 0xa4, 0x28, 0x1c, 0xf9, 0xf2, 0xab, 0x53, 0x7d, 0x7f, 0xf4, 0xea, 0x54,
+		0x7c, 0xd1, 0x70, 0x7f, 0xff, 0x95, 0x87, 0x80, 0x43, 0x2d, 0x1a, 0xae, 0xc2, 0x09, 0xf3, 0x89,
+		0xef, 0xbe, 0x3c, 0x25, 0xc9, 0x4f, 0xc1, 0xe0, 0x3c, 0x2e, 0xef, 0x07, 0x79, 0x1e, 0x97, 0x03,
+		0xc8, 0xf3, 0xb8, 0xdc, 0x80, 0x7c, 0x16, 0x92, 0x8b, 0x86, 0xcb, 0x2e, 0x4d, 0x3e, 0x08, 0x71,
+		0xcd, 0x60, 0xf7, 0x70, 0x3a, 0xca, 0x46, 0xa8, 0x08, 0xe3, 0x3c, 0x2e, 0x7b, 0x8c, 0x15, 0x5c,
+		0xce, 0x4a, 0x51, 0x55, 0x13, 0xaa, 0xc2, 0xfc, 0x1f, 0xfe, 0xa7, 0xc9, 0xbe, 0x17, 0x5f, 0x9b,
+		0xec, 0x6b, 0xdb, 0xab, 0x72, 0xdb, 0x5e, 0x75, 0x2a, 0xbb, 0xec, 0x78, 0xc5, 0xeb, 0xd9, 0x3f,
+		0x1b, 0x00, 0x99, 0xd3, 0x38, 0xae, 0xba, 0xab, 0x19, 0x55, 0xaf, 0x73, 0xd5, 0xba, 0xbb, 0xf3,
+		0x02, 0xef, 0xdd, 0xc3, 0x5c, 0x0a, 0x4e, 0xb3, 0xef, 0x0e, 0xce, 0x45, 0x98, 0x91, 0xfc, 0xa7,
+		0x71, 0x40, 0xeb, 0xae, 0xba, 0x8b, 0x67, 0xeb, 0xee, 0x8e, 0x69, 0x6b, 0x2f, 0xb0, 0x69, 0x10,
+		0x03, 0xd4, 0xd4, 0xeb, 0x25, 0xd7, 0xdc, 0xc5, 0x86, 0x43, 0x15, 0x35, 0x74, 0xea, 0xc8, 0x4c,
+		0x0b, 0x93, 0x9b, 0x21, 0x9d, 0x5c, 0x78, 0xf0, 0x13, 0xdf, 0x9c, 0xba, 0x3f, 0x5a, 0x0b, 0x94,
+		0x98, 0xf8, 0xe5, 0xd7, 0x37, 0x28, 0x30, 0xba, 0x0a, 0xec, 0x7e, 0x46, 0x49, 0xd7, 0x1c, 0x97,
+		0x5f, 0xf1, 0x3e, 0x3d, 0xd3, 0xba, 0xed, 0x33, 0xcd, 0x62, 0xce, 0x5c, 0x55, 0x75, 0xad, 0xa2,
+		0xba, 0xa6, 0xed, 0x5c, 0xea, 0x53, 0x52, 0x14, 0x6a, 0x49, 0x73, 0x5c, 0xb4, 0x01, 0xa9, 0x0a,
+		0x36, 0xf6, 0x18, 0x6c, 0xfc, 0xe6, 0x60, 0x93, 0x04, 0x89, 0xa2, 0x3e, 0x05, 0x48, 0x0d, 0xd2,
+		0x89, 0x37, 0x4d, 0xec, 0x6a, 0x66, 0x1b, 0xf8, 0x10, 0x32, 0x7d, 0x82, 0x31, 0xa6, 0x36, 0x66,
+		0xe5, 0xde, 0x02, 0xe0, 0xd7, 0x89, 0x4e, 0xc1, 0xa0, 0x5a, 0xa9, 0xd8, 0xd8, 0x71, 0xe8, 0xd9,
+		0x61, 0xaa, 0x90, 0xfd, 0xfd, 0x57, 0x1e, 0x9a, 0xe0, 0xf8, 0xb3, 0xac, 0x84, 0x6d, 0xc7, 0x15,
+		0x41, 0x98, 0x1f, 0xfb, 0xea, 0x2b, 0x0f, 0x0d, 0x87, 0xea, 0x2a, 0xa4, 0x01, 0xae, 0x79, 0xa0,
+		0x27, 0x3e, 0x2c, 0xc1, 0x58, 0x93, 0x2c, 0x48, 0x86, 0xc9, 0xd9, 0xcd, 0x8d, 0x4b, 0xab, 0xca,
+		0xe2, 0x33, 0xb3, 0xe4, 0x26, 0x7f, 0x89, 0xbd, 0x23, 0x58, 0x59, 0x5f, 0x2b, 0xce, 0x2d, 0x5e,
+		0x5c, 0x2c, 0xce, 0x67, 0xfa, 0xd0, 0x14, 0x1c, 0x6d, 0x41, 0x33, 0x5f, 0x5c, 0x2a, 0x2e, 0xcc,
+		0x6e, 0x90, 0x57, 0x13, 0xc7, 0xe0, 0xae, 0x96, 0x20, 0x1e, 0x49, 0xac, 0x0d, 0x89, 0x52, 0xf4,
+		0x48, 0xe2, 0x85, 0x8b, 0x6d, 0xc7, 0xd7, 0x1b, 0x3a, 0x5a, 0xd6, 0x75, 0x6f, 0x20, 0x85, 0x47,
+		0xda, 0x3b, 0x62, 0x70, 0x84, 0x4d, 0xdb, 0xfe, 0x3a, 0xa4, 0x1a, 0x7b, 0x6d, 0x9e, 0x92, 0xb6,
+		0x1e, 0x59, 0xf2, 0x25, 0x88, 0xcf, 0x1a, 0x7b, 0xe8, 0x08, 0x73, 0xd2, 0x4b, 0x75, 0x5b, 0xe7,
+		0xf3, 0xd8, 0x20, 0x49, 0x6f, 0xda, 0x3a, 0x99, 0xdf, 0xc4, 0xeb, 0x01, 0x72, 0x27, 0x80, 0x25,
+		0xf2, 0x99, 0xf7, 0xbf, 0x3c, 0xd5, 0xf7, 0xe9, 0x97, 0xa7, 0xfa, 0x7e, 0xf0, 0x91, 0xa9, 0xbe,
+		0x17, 0xff, 0x78, 0xba, 0xaf, 0xb0, 0xdb, 0xd8, 0xbc, 0x2f, 0x46, 0x2e, 0xd1, 0xc9, 0x59, 0x63,
+		0x8f, 0x4e, 0x58, 0x6b, 0xd2, 0x33, 0xfd, 0xb4, 0x71, 0xe2, 0x54, 0x76, 0xb2, 0xf1, 0x54, 0xf6,
+		0x49, 0xac, 0xeb, 0x57, 0x0c, 0xf3, 0x79, 0x63, 0x23, 0xa4, 0x83, 0xf7, 0xc5, 0x60, 0xb2, 0x69,
+		0x2d, 0xe6, 0x6e, 0x4b, 0xbb, 0x37, 0xb5, 0x79, 0x48, 0xce, 0x73, 0x12, 0xf2, 0xc8, 0xd5, 0xc1,
+		0x65, 0xd3, 0xa8, 0xb0, 0x39, 0x20, 0xae, 0x88, 0x24, 0x69, 0xb6, 0xa1, 0x1a, 0xa6, 0xc3, 0x2f,
+		0xf2, 0xb3, 0x44, 0xe1, 0x97, 0xa5, 0xde, 0x9c, 0x90, 0x61, 0x51, 0x93, 0x68, 0xe6, 0x23, 0x91,
+		0xe7, 0xd4, 0xbb, 0xa4, 0x95, 0x5e, 0x23, 0x42, 0x67, 0xd5, 0xdd, 0x6a, 0xe5, 0x97, 0x62, 0x30,
+		0xd5, 0xa8, 0x15, 0xe2, 0x0b, 0x3a, 0xae, 0x5a, 0xb3, 0xda, 0xa9, 0xe5, 0x02, 0xa4, 0x36, 0x04,
+		0x4d, 0xcf, 0x7a, 0xb9, 0xd1, 0xa3, 0x5e, 0x46, 0xbc, 0xaa, 0x84, 0x62, 0x4e, 0x75, 0xa9, 0x18,
+		0xaf, 0x1d, 0xfb, 0xd2, 0xcc, 0x27, 0x12, 0x70, 0x17, 0x7d, 0xe9, 0x65, 0xd7, 0x34, 0xc3, 0x3d,
+		0x59, 0xb6, 0xf7, 0x2c, 0x97, 0x7a, 0x83, 0xe6, 0x36, 0xd7, 0xcb, 0x98, 0x5f, 0x3c, 0xc3, 0x8a,
+		0xdb, 0x8c, 0x9c, 0x6d, 0xe8, 0x5f, 0x23, 0x7c, 0x44, 0x23, 0xae, 0xe9, 0xaa, 0x3a, 0xd7, 0x14,
+		0x4b, 0x90, 0x5c, 0xf6, 0x3a, 0x2c, 0xc6, 0x72, 0x35, 0xf1, 0x30, 0x4c, 0xc7, 0xea, 0x36, 0xbb,
+		0x64, 0x1f, 0xa7, 0x03, 0x2a, 0x49, 0x32, 0xe8, 0x7d, 0xfa, 0x09, 0xe8, 0x57, 0xeb, 0xec, 0x7e,
+		0x48, 0x9c, 0x8c, 0x34, 0x9a, 0x90, 0xaf, 0xc0, 0x20, 0x3f, 0xa5, 0x26, 0x37, 0x24, 0x76, 0xf1,
+		0x1e, 0xad, 0x27, 0xad, 0x90, 0x9f, 0x68, 0x06, 0xfa, 0xa9, 0xf0, 0x7c, 0x69, 0xc9, 0xce, 0x34,
+		0x49, 0x3f, 0x43, 0x85, 0x54, 0x18, 0x99, 0x7c, 0x19, 0x92, 0xf3, 0x66, 0x4d, 0x33, 0xcc, 0x30,
+		0x5a, 0x8a, 0xa1, 0x51, 0x99, 0xad, 0x3a, 0xf7, 0x59, 0x14, 0x96, 0x20, 0x97, 0x51, 0xd9, 0xa3,
+		0x0b, 0x7e, 0xc7, 0x85, 0xa7, 0xe4, 0x39, 0x18, 0xa4, 0xd8, 0xab, 0x16, 0x79, 0xdd, 0xe1, 0xdd,
+		0x78, 0x4d, 0xf1, 0x27, 0x78, 0x1c, 0x3e, 0xe6, 0x0b, 0x8b, 0x20, 0x51, 0x51, 0x5d, 0x95, 0xb7,
+		0x9b, 0xfe, 0x96, 0xdf, 0x0c, 0x49, 0x0e, 0x42, 0x96, 0x85, 0xb8, 0x69, 0x39, 0xfc, 0x96, 0x4a,
+		0xae, 0x5d, 0x53, 0x56, 0xad, 0x42, 0x82, 0x78, 0x34, 0x0a, 0x21, 0x2e, 0x28, 0x6d, 0x27, 0xd5,
+		0x73, 0x81, 0x49, 0x35, 0xd0, 0xe5, 0x81, 0x9f, 0xac, 0x4b, 0x9b, 0xcc, 0xc1, 0x33, 0x96, 0x8f,
+		0xc4, 0x60, 0x32, 0x50, 0x7a, 0x0d, 0xdb, 0x8e, 0x66, 0x1a, 0x7c, 0xa5, 0x67, 0xd6, 0x82, 0x02,
+		0x42, 0xf2, 0xf2, 0x36, 0xe6, 0xf2, 0x26, 0x88, 0xcf, 0x5a, 0x16, 0x79, 0x7b, 0x48, 0xd3, 0x65,
+		0x93, 0xd9, 0x4b, 0x42, 0xf1, 0xd2, 0xa4, 0xcc, 0x31, 0xb7, 0xdd, 0xe7, 0x55, 0xdb, 0x7b, 0x97,
+		0x28, 0xd2, 0xf2, 0x79, 0x48, 0xcd, 0x99, 0x86, 0x83, 0x0d, 0xa7, 0x4e, 0xc7, 0xe0, 0x96, 0x6e,
+		0x96, 0x77, 0x39, 0x02, 0x4b, 0x10, 0x85, 0xab, 0x96, 0x45, 0x39, 0x13, 0x0a, 0xf9, 0xc9, 0x3c,
+		0xca, 0xc2, 0x7a, 0x5b, 0x15, 0x9d, 0xef, 0x5d, 0x45, 0xbc, 0x91, 0x9e, 0x8e, 0xfe, 0x4a, 0x82,
+		0x3b, 0x9b, 0x07, 0xd4, 0x2e, 0xde, 0x73, 0x7a, 0x1d, 0x4f, 0x4f, 0x41, 0x6a, 0x8d, 0x7e, 0x1c,
+		0xe0, 0x0a, 0xde, 0x43, 0x39, 0x18, 0xc4, 0x95, 0x53, 0xa7, 0x4f, 0x3f, 0x72, 0x9e, 0x59, 0xfb,
+		0xa5, 0x3e, 0x45, 0x64, 0xa0, 0x49, 0x48, 0x39, 0xb8, 0x6c, 0x9d, 0x3a, 0x7d, 0x66, 0xf7, 0x11,
+		0x66, 0x5e, 0xc4, 0x37, 0xf2, 0xb2, 0xf2, 0x49, 0xd2, 0xea, 0xef, 0x7e, 0x64, 0x4a, 0x2a, 0xf4,
+		0x43, 0xdc, 0xa9, 0xd7, 0x6e, 0xa9, 0x8d, 0x7c, 0xb0, 0x1f, 0xa6, 0x83, 0x9c, 0x74, 0xa6, 0xf2,
+		0xbc, 0x12, 0xae, 0x83, 0x4c, 0x40, 0x07, 0x94, 0xa2, 0x8d, 0x9b, 0xdb, 0x51, 0x93, 0xf2, 0x6f,
+		0x48, 0x90, 0xf6, 0x9c, 0x28, 0xf2, 0x1d, 0x88, 0x0b, 0x41, 0xff, 0x87, 0x0f, 0x9b, 0xa3, 0x33,
+		0x8d, 0x75, 0xf9, 0xce, 0x9e, 0x12, 0x20, 0x47, 0x67, 0xa9, 0x21, 0x5a, 0xa6, 0xc3, 0xdf, 0xaa,
+		0x45, 0xb0, 0x7a, 0xc4, 0xe4, 0xee, 0x21, 0x9d, 0xe1, 0x4a, 0xd7, 0x4c, 0x97, 0x5c, 0xc6, 0xb0,
+		0xcc, 0xe7, 0xf9, 0x0b, 0xe0, 0xb8, 0x92, 0xa1, 0x25, 0x57, 0x69, 0xc1, 0x1a, 0xc9, 0x27, 0x42,
+		0xa7, 0x3c, 0x14, 0xb2, 0xac, 0xf8, 0x8e, 0x1f, 0x99, 0x04, 0x44, 0x92, 0x3c, 0x90, 0xb3, 0xea,
+		0x5b, 0x25, 0x31, 0x63, 0x90, 0x27, 0x86, 0x2d, 0xc6, 0xbf, 0xb0, 0x0f, 0x3e, 0x03, 0x0c, 0x58,
+		0xf5, 0x2d, 0x62, 0x2d, 0xc7, 0x20, 0xdd, 0x42, 0x98, 0xa1, 0x6b, 0xbe, 0x1c, 0xf4, 0x9b, 0x14,
+		0xbc, 0x05, 0x25, 0xcb, 0xd6, 0x4c, 0x5b, 0x73, 0xf7, 0xa8, 0x67, 0x1b, 0x57, 0x32, 0xa2, 0x60,
+		0x8d, 0xe7, 0xcb, 0xbb, 0x30, 0xba, 0x4e, 0xb7, 0xdf, 0xbe, 0xe4, 0xa7, 0x7d, 0xf9, 0xa4, 0x68,
+		0xf9, 0xda, 0x4a, 0x16, 0x6b, 0x92, 0xac, 0xf0, 0x44, 0x5b, 0xeb, 0x3c, 0xdb, 0xbb, 0x75, 0x86,
+		0x3d, 0xc4, 0xbf, 0x38, 0x02, 0x77, 0x36, 0x16, 0x86, 0xa6, 0xaf, 0x6e, 0x0d, 0x33, 0xca, 0x9b,
+		0xc8, 0x75, 0x5e, 0x54, 0x73, 0x11, 0xd3, 0x68, 0x2e, 0x72, 0x08, 0xc9, 0xe7, 0x61, 0x98, 0xdc,
+		0x19, 0x5d, 0xc7, 0xee, 0x25, 0xac, 0x56, 0xb0, 0x1d, 0x5e, 0x75, 0x87, 0xc5, 0xaa, 0x8b, 0x20,
+		0x41, 0x97, 0x56, 0xb6, 0xea, 0xd0, 0xdf, 0xf2, 0x0e, 0x24, 0x08, 0xab, 0xbf, 0x22, 0x73, 0x0e,
+		0x9a, 0x20, 0xb9, 0x5b, 0x7b, 0x2e, 0x76, 0x84, 0x7b, 0x4b, 0x13, 0xe8, 0x31, 0xb1, 0xae, 0xc6,
+		0x3b, 0xaf, 0xab, 0xdc, 0x10, 0xf9, 0xea, 0xaa, 0xc3, 0x60, 0x81, 0x4c, 0xc5, 0x8b, 0xf3, 0x9e,
+		0x20, 0x92, 0x2f, 0x08, 0x5a, 0x86, 0x51, 0x4b, 0xb5, 0x5d, 0xfa, 0xce, 0x66, 0x87, 0xb6, 0x82,
+		0xdb, 0xfa, 0x54, 0xf3, 0xc8, 0x0b, 0x35, 0x96, 0xd7, 0x32, 0x6c, 0x05, 0x33, 0xe5, 0x3f, 0x4d,
+		0xc0, 0x00, 0x57, 0xc6, 0x9b, 0x60, 0x90, 0xab, 0x95, 0x5b, 0xe7, 0x5d, 0x33, 0xcd, 0x0b, 0xd3,
+		0x8c, 0xb7, 0x80, 0x70, 0x3c, 0xc1, 0x83, 0xee, 0x83, 0x64, 0x79, 0x47, 0xd5, 0x8c, 0x92, 0x56,
+		0xe1, 0xe1, 0x8a, 0xa1, 0xd7, 0x5e, 0x9d, 0x1a, 0x9c, 0x23, 0x79, 0x8b, 0xf3, 0xca, 0x20, 0x2d,
+		0x5c, 0xac, 0x10, 0x4f, 0x60, 0x07, 0x6b, 0xd5, 0x1d, 0x97, 0x8f, 0x30, 0x9e, 0x22, 0x1f, 0xa4,
+		0x21, 0x06, 0xc1, 0x5f, 0x61, 0xe6, 0x9a, 0x82, 0x49, 0x9e, 0xb3, 0x57, 0x48, 0x92, 0x8a, 0xdf,
+		0xfb, 0xcd, 0x29, 0x49, 0xa1, 0x1c, 0x68, 0x0e, 0x86, 0x75, 0xd5, 0x71, 0x4b, 0x74, 0x05, 0x23,
+		0xd5, 0xf7, 0xf3, 0x9d, 0x78, 0x93, 0x42, 0xb8, 0x62, 0xb9, 0xe8, 0x43, 0x84, 0x8b, 0x65, 0x55,
+		0xc8, 0x23, 0x31, 0x0a, 0x42, 0xae, 0xca, 0x6a, 0x2e, 0xf3, 0xad, 0x06, 0xa8, 0xde, 0x47, 0x48,
+		0xfe, 0x1c, 0xcd, 0xa6, 0x1e, 0xd6, 0x51, 0x48, 0xd1, 0x77, 0x5f, 0x94, 0x84, 0xdd, 0x71, 0x4e,
+		0x92, 0x0c, 0x5a, 0x78, 0x3f, 0x8c, 0xfa, 0xf3, 0x23, 0x23, 0x49, 0x32, 0x14, 0x3f, 0x9b, 0x12,
+		0x3e, 0x0c, 0x13, 0x06, 0xbe, 0xee, 0x96, 0xfc, 0x6c, 0x46, 0x9d, 0xa2, 0xd4, 0x88, 0x94, 0x5d,
+		0x0d, 0x73, 0xdc, 0x0b, 0x23, 0x65, 0xa1, 0x7c, 0x46, 0x0b, 0x94, 0x76, 0xd8, 0xcb, 0xa5, 0x64,
+		0x47, 0x20, 0xa9, 0x5a, 0x16, 0x23, 0x18, 0xe2, 0xf3, 0xa3, 0x65, 0xd1, 0xa2, 0x13, 0x30, 0x46,
+		0xdb, 0x68, 0x63, 0xa7, 0xae, 0xbb, 0x1c, 0x24, 0x4d, 0x69, 0x46, 0x49, 0x81, 0xc2, 0xf2, 0x29,
+		0xed, 0xdd, 0x30, 0x8c, 0xaf, 0x69, 0x15, 0x6c, 0x94, 0x31, 0xa3, 0x1b, 0xa6, 0x74, 0x69, 0x91,
+		0x49, 0x89, 0x1e, 0x00, 0x6f, 0xde, 0x2b, 0x89, 0x39, 0x79, 0x84, 0xe1, 0x89, 0x7c, 0xbe, 0x13,
+		0x97, 0xb3, 0x90, 0x98, 0x57, 0x5d, 0x95, 0x38, 0x18, 0xee, 0x75, 0xb6, 0xd0, 0xa4, 0x15, 0xf2,
+		0x53, 0xfe, 0x6e, 0x0c, 0x12, 0x57, 0x4d, 0x17, 0xa3, 0x47, 0x03, 0x0e, 0xe0, 0x48, 0x2b, 0x7b,
+		0x5e, 0xd7, 0xaa, 0x06, 0xae, 0x2c, 0x3b, 0xd5, 0xc0, 0x47, 0x1a, 0x7c, 0x73, 0x8a, 0x85, 0xcc,
+		0x69, 0x02, 0xfa, 0x6d, 0xb3, 0x6e, 0x54, 0xc4, 0xf5, 0x60, 0x9a, 0x40, 0x45, 0x48, 0x7a, 0x56,
+		0x92, 0x88, 0xb2, 0x92, 0x51, 0x62, 0x25, 0xc4, 0x86, 0x79, 0x86, 0x32, 0xb8, 0xc5, 0x8d, 0xa5,
+		0x00, 0x29, 0x6f, 0xf2, 0xca, 0xf6, 0xf7, 0x60, 0xb0, 0x3e, 0x1b, 0x59, 0x4c, 0xbc, 0xbe, 0xf7,
+		0x94, 0xc7, 0x2c, 0x2e, 0xe3, 0x15, 0x70, 0xed, 0x85, 0xcc, 0x8a, 0x7f, 0x30, 0x62, 0x90, 0xb6,
+		0xcb, 0x37, 0x2b, 0xf6, 0xd1, 0x88, 0x3b, 0xc9, 0x6d, 0xaf, 0xaa, 0xa1, 0xba, 0x75, 0x1b, 0x73,
+		0xcb, 0xf3, 0x33, 0xc8, 0x63, 0xa0, 0x01, 0x66, 0xc9, 0x01, 0xbd, 0x49, 0xad, 0xf5, 0x16, 0x6b,
+		0xa7, 0xb7, 0xf8, 0xfe, 0xf5, 0x36, 0x0b, 0xe0, 0x09, 0xe3, 0xf0, 0x77, 0xfc, 0x2d, 0x3c, 0x06,
+		0x26, 0xe2, 0xba, 0x56, 0xe5, 0x03, 0x35, 0xc0, 0x24, 0xff, 0x89, 0x04, 0x29, 0xaf, 0x1c, 0xcd,
+		0xc2, 0xb0, 0x90, 0xab, 0xb4, 0xad, 0xab, 0x55, 0x6e, 0x3b, 0x77, 0xb5, 0x15, 0xee, 0xa2, 0xae,
+		0x56, 0x95, 0x21, 0x2e, 0x0f, 0x49, 0xb4, 0xee, 0x87, 0x58, 0x9b, 0x7e, 0x08, 0x75, 0x7c, 0x7c,
+		0x7f, 0x1d, 0x1f, 0xea, 0xa2, 0x44, 0x63, 0x17, 0x7d, 0x36, 0x46, 0x37, 0x33, 0x96, 0xe9, 0xa8,
+		0xfa, 0x4f, 0x62, 0x44, 0x1c, 0x85, 0x94, 0x65, 0xea, 0x25, 0x56, 0xc2, 0xae, 0xcd, 0x27, 0x2d,
+		0x53, 0x57, 0x9a, 0xba, 0xbd, 0xff, 0x80, 0x86, 0xcb, 0xc0, 0x01, 0x68, 0x6d, 0xb0, 0x51, 0x6b,
+		0x36, 0xa4, 0x99, 0x2a, 0xf8, 0x5a, 0xf6, 0x30, 0xd1, 0x01, 0xf9, 0x95, 0x95, 0x9a, 0xd7, 0x5e,
+		0x26, 0x36, 0xa3, 0x54, 0x06, 0x76, 0x3c, 0x0e, 0x36, 0xf5, 0x67, 0x63, 0xed, 0x38, 0x98, 0xd9,
+		0x29, 0x9c, 0x4e, 0xfe, 0x07, 0x12, 0xc0, 0x12, 0xd1, 0x2c, 0x6d, 0x2f, 0x59, 0x85, 0x1c, 0x2a,
+		0x42, 0x29, 0x54, 0xf3, 0x64, 0xbb, 0x4e, 0xe3, 0xf5, 0xa7, 0x9d, 0xa0, 0xdc, 0x73, 0x30, 0xec,
+		0x1b, 0xa3, 0x83, 0x85, 0x30, 0x93, 0x1d, 0xbc, 0xea, 0x75, 0xec, 0x2a, 0xe9, 0x6b, 0x81, 0x94,
+		0xfc, 0x2f, 0x25, 0x48, 0x51, 0x99, 0xc8, 0x2b, 0xe4, 0x50, 0x1f, 0x4a, 0xfb, 0xef, 0xc3, 0xbb,
+		0x00, 0x18, 0x0c, 0x39, 0xfb, 0xe6, 0x96, 0x95, 0xa2, 0x39, 0xe4, 0x44, 0x1b, 0x9d, 0xf1, 0x14,
+		0x1e, 0xef, 0xac, 0x70, 0xe1, 0x75, 0x73, 0xb5, 0xdf, 0x01, 0x83, 0xf4, 0xbb, 0x57, 0xd7, 0x1d,
+		0xee, 0x48, 0x93, 0x8f, 0x5d, 0x6c, 0x5c, 0x77, 0xe4, 0x67, 0x61, 0x70, 0xe3, 0x3a, 0x8b, 0x8d,
+		0x1c, 0x85, 0x94, 0x6d, 0x9a, 0x7c, 0x4d, 0x66, 0xbe, 0x50, 0x92, 0x64, 0xd0, 0x25, 0x48, 0xc4,
+		0x03, 0x62, 0x7e, 0x3c, 0xc0, 0x0f, 0x68, 0xc4, 0xbb, 0x0a, 0x68, 0x9c, 0xf8, 0x03, 0x09, 0x86,
+		0x02, 0xf3, 0x03, 0x7a, 0x04, 0x0e, 0x15, 0x96, 0x56, 0xe7, 0xae, 0x94, 0x16, 0xe7, 0x4b, 0x17,
+		0x97, 0x66, 0x17, 0xfc, 0x87, 0x61, 0xb9, 0xc3, 0x2f, 0xdd, 0x98, 0x46, 0x01, 0xda, 0x4d, 0x83,
+		0x46, 0x94, 0xd0, 0x49, 0x98, 0x08, 0xb3, 0xcc, 0x16, 0xd6, 0xc9, 0x2b, 0x31, 0x29, 0x77, 0xe8,
+		0xa5, 0x1b, 0xd3, 0x63, 0x01, 0x8e, 0xd9, 0x2d, 0x07, 0x1b, 0x6e, 0x33, 0xc3, 0xdc, 0xea, 0xf2,
+		0xf2, 0xe2, 0x46, 0x26, 0xd6, 0xc4, 0xc0, 0x27, 0xec, 0x07, 0x60, 0x2c, 0xcc, 0xb0, 0xb2, 0xb8,
+		0x94, 0x89, 0xe7, 0xd0, 0x4b, 0x37, 0xa6, 0x47, 0x02, 0xd4, 0x2b, 0x9a, 0x9e, 0x4b, 0xbe, 0xfb,
+		0xa3, 0x93, 0x7d, 0xbf, 0xf6, 0xb1, 0x49, 0x89, 0xb4, 0x6c, 0x38, 0x34, 0x47, 0xa0, 0x37, 0xc0,
+		0x1d, 0xeb, 0x8b, 0x0b, 0x2b, 0xc5, 0xf9, 0xd2, 0xf2, 0xfa, 0x82, 0x88, 0x41, 0x8b, 0xd6, 0x8d,
+		0xbe, 0x74, 0x63, 0x7a, 0x88, 0x37, 0xa9, 0x1d, 0xf5, 0x9a, 0x52, 0xbc, 0xba, 0x4a, 0x22, 0xda,
+		0x8c, 0x7a, 0xcd, 0xc6, 0xd7, 0x4c, 0x97, 0x7d, 0x18, 0xef, 0x61, 0x38, 0xd2, 0x82, 0xda, 0x6b,
+		0xd8, 0xd8, 0x4b, 0x37, 0xa6, 0x87, 0xd7, 0x6c, 0xcc, 0xc6, 0x0f, 0xe5, 0x98, 0x81, 0x6c, 0x33,
+		0xc7, 0xea, 0xda, 0xea, 0xfa, 0xec, 0x52, 0x66, 0x3a, 0x97, 0x79, 0xe9, 0xc6, 0x74, 0x5a, 0x4c,
+		0x86, 0xf4, 0x08, 0xc0, 0x6b, 0xd9, 0xad, 0xdc, 0xf1, 0xfc, 0xeb, 0x19, 0xb8, 0xa7, 0xcd, 0xe9,
+		0x13, 0x4f, 0xef, 0xef, 0xfc, 0xa9, 0x6d, 0x9c, 0x3d, 0x17, 0x11, 0x7e, 0x8e, 0xde, 0x3a, 0xed,
+		0xff, 0x6c, 0x2b, 0xd7, 0x71, 0x73, 0x27, 0xbf, 0x47, 0x82, 0x91, 0x4b, 0x9a, 0xe3, 0x9a, 0xb6,
+		0x56, 0x56, 0x75, 0xfa, 0x1c, 0xec, 0x4c, 0xb7, 0x73, 0x6b, 0xc3, 0x50, 0x7f, 0x1c, 0x06, 0xae,
+		0xa9, 0x3a, 0x9b, 0xd4, 0xe2, 0xf4, 0xeb, 0x35, 0x6d, 0x0e, 0x83, 0xbc, 0xa9, 0x4d, 0x00, 0x30,
+		0x36, 0xf9, 0x53, 0x31, 0x18, 0xa5, 0x83, 0xc1, 0x61, 0xdf, 0x35, 0x23, 0x7b, 0xac, 0x35, 0x48,
+		0xd8, 0xaa, 0xcb, 0x83, 0x86, 0x85, 0x37, 0xf2, 0x53, 0xca, 0xfb, 0xba, 0x38, 0x65, 0x6b, 0x3e,
+		0xc8, 0xa4, 0x48, 0xe8, 0x49, 0x48, 0x92, 0x43, 0x3d, 0x8a, 0x1a, 0x3b, 0x00, 0xd4, 0xc1, 0x9a,
+		0x7a, 0x9d, 0xc8, 0x8a, 0x2a, 0x30, 0x4a, 0x80, 0xcb, 0x3b, 0xaa, 0x51, 0xc5, 0x0c, 0x3f, 0x7e,
+		0x00, 0xf8, 0xc3, 0x35, 0xf5, 0xfa, 0x1c, 0xc5, 0x24, 0xb5, 0xe4, 0x93, 0xe4, 0x4c, 0x85, 0x1e,
+		0x02, 0xff, 0xb6, 0x04, 0xe0, 0xab, 0x0b, 0xfd, 0x14, 0x64, 0xca, 0x5e, 0x8a, 0x56, 0x2f, 0x8e,
+		0x2c, 0xef, 0x6f, 0xd7, 0x11, 0x0d, 0xca, 0x66, 0x0b, 0xf3, 0xd7, 0x5e, 0x9d, 0x92, 0x94, 0xd1,
+		0x72, 0x43, 0x3f, 0x14, 0x61, 0xa8, 0x6e, 0x55, 0x54, 0x17, 0x97, 0xe8, 0x26, 0x2e, 0xd6, 0xc3,
+		0x22, 0x0f, 0x8c, 0x91, 0x14, 0x05, 0xa4, 0xff, 0x94, 0x04, 0x43, 0xf3, 0x81, 0xfb, 0x98, 0x59,
+		0x18, 0xac, 0x99, 0x86, 0xb6, 0xcb, 0xcd, 0x2e, 0xa5, 0x88, 0x24, 0x89, 0x78, 0xb2, 0x87, 0xb0,
+		0xee, 0x9e, 0x88, 0x78, 0x8a, 0x34, 0xe1, 0x7a, 0x1e, 0x6f, 0x39, 0x9a, 0xd0, 0xb5, 0x22, 0x92,
+		0x64, 0xeb, 0xe2, 0xe0, 0x72, 0x9d, 0x84, 0x6a, 0x4a, 0x65, 0xd3, 0x70, 0xd5, 0xb2, 0xcb, 0x9f,
+		0x54, 0x8e, 0x8a, 0xfc, 0x39, 0x96, 0x4d, 0x40, 0x2a, 0xd8, 0x55, 0x35, 0xdd, 0xc9, 0xb2, 0x2b,
+		0x0c, 0x22, 0x19, 0x10, 0xf7, 0x0b, 0x83, 0xc1, 0x10, 0xd5, 0x1c, 0x64, 0x4c, 0x0b, 0xdb, 0x21,
+		0x97, 0x92, 0x59, 0x68, 0xfb, 0x43, 0xca, 0x51, 0xc1, 0xc1, 0xb3, 0xd1, 0xd3, 0x90, 0xf1, 0x76,
+		0x76, 0x25, 0xab, 0xbe, 0xe5, 0x87, 0xb5, 0x26, 0x9a, 0xf4, 0x3a, 0x6b, 0xec, 0x15, 0xb2, 0x5f,
+		0xf5, 0xa1, 0xfd, 0x58, 0x12, 0x09, 0x24, 0x8d, 0x7a, 0x38, 0x6b, 0x14, 0x86, 0xb8, 0x88, 0xcf,
+		0xaa, 0x9a, 0x2e, 0xde, 0xf7, 0x2b, 0x3c, 0x85, 0xf2, 0x30, 0xe0, 0xb8, 0xaa, 0x5b, 0x77, 0xf8,
+		0x79, 0xad, 0xdc, 0xce, 0x32, 0x0a, 0xa6, 0x51, 0x59, 0xa7, 0x94, 0x0a, 0xe7, 0x40, 0x1b, 0x30,
+		0xc0, 0x0f, 0xc2, 0xfb, 0x7b, 0xb6, 0xea, 0x16, 0x37, 0x25, 0x18, 0x16, 0xaa, 0x42, 0xa6, 0x82,
+		0x75, 0x5c, 0x65, 0x0e, 0xd1, 0x8e, 0x4a, 0xf6, 0x0d, 0x03, 0x07, 0x30, 0x6a, 0x46, 0x3d, 0xd4,
+		0x75, 0x0a, 0x8a, 0xae, 0x84, 0xae, 0xff, 0xf2, 0x4f, 0x54, 0xde, 0xdd, 0xae, 0xfd, 0x01, 0xcb,
+		0x14, 0xc1, 0x84, 0x00, 0x37, 0x31, 0xae, 0xba, 0xb1, 0x65, 0x1a, 0xf4, 0x15, 0x2e, 0x77, 0xc6,
+		0x93, 0xd4, 0xbd, 0x19, 0xf5, 0xf2, 0x2f, 0xd1, 0x6c, 0x74, 0x05, 0x46, 0x7c, 0x52, 0x3a, 0x76,
+		0x52, 0x3d, 0x8c, 0x9d, 0x61, 0x8f, 0x97, 0x94, 0xa2, 0x4b, 0x00, 0xfe, 0xc0, 0xa4, 0xe1, 0x81,
+		0xa1, 0x53, 0x72, 0xf4, 0xe8, 0x16, 0xdb, 0x2c, 0x9f, 0x17, 0xe9, 0x30, 0x5e, 0xd3, 0x8c, 0x92,
+		0x83, 0xf5, 0xed, 0x12, 0x57, 0x15, 0x81, 0x1c, 0x3a, 0x80, 0xae, 0x1d, 0xab, 0x69, 0xc6, 0x3a,
+		0xd6, 0xb7, 0xe7, 0x3d, 0x58, 0x64, 0xc0, 0x04, 0xbb, 0x48, 0x41, 0x42, 0xc2, 0x81, 0xea, 0xd2,
+		0x07, 0x50, 0x1d, 0xa2, 0x17, 0x29, 0x5c, 0x55, 0xf7, 0xeb, 0xcb, 0xa7, 0xdf, 0xfd, 0xf2, 0x54,
+		0x1f, 0x1f, 0xbb, 0x7d, 0xf2, 0x1a, 0x0d, 0x89, 0xf3, 0x61, 0x87, 0x1d, 0x74, 0x06, 0x52, 0xaa,
+		0x48, 0x44, 0xde, 0x2d, 0xf0, 0x49, 0xd9, 0x6c, 0xf0, 0xe2, 0x1f, 0x4f, 0x4b, 0xf2, 0xc7, 0x24,
+		0x18, 0x98, 0xbf, 0xba, 0xa6, 0x6a, 0x36, 0x2a, 0xc2, 0x98, 0x6f, 0xc0, 0xdd, 0xce, 0x05, 0xbe,
+		0xcd, 0xf3, 0x7c, 0x02, 0xd3, 0x7a, 0x97, 0xda, 0x11, 0xa6, 0x71, 0xff, 0xda, 0xd0, 0xf0, 0x22,
+		0x0c, 0x32, 0x29, 0xc9, 0xab, 0xf1, 0x7e, 0x8b, 0xfc, 0xe0, 0x27, 0x00, 0x93, 0x6d, 0x0d, 0x9f,
+		0xd2, 0x7b, 0x11, 0x4b, 0xc2, 0x22, 0xff, 0x95, 0x04, 0x30, 0x7f, 0xf5, 0xea, 0x86, 0xad, 0x59,
+		0x3a, 0x76, 0x0f, 0xaa, 0xc5, 0x4b, 0x70, 0xc8, 0x6f, 0xb1, 0x63, 0x97, 0xbb, 0x6e, 0xf5, 0xb8,
+		0xbf, 0x19, 0xb2, 0xcb, 0x2d, 0xd1, 0x2a, 0x8e, 0xeb, 0xa1, 0xc5, 0xbb, 0x46, 0x9b, 0x77, 0xdc,
+		0xd6, 0x6a, 0x5c, 0x87, 0x21, 0xbf, 0xf9, 0xe4, 0xbb, 0x68, 0x49, 0x97, 0xff, 0xe6, 0xda, 0x94,
+		0xdb, 0x6b, 0x53, 0xb0, 0x71, 0x8d, 0x7a, 0x9c, 0xf2, 0xff, 0x21, 0x4a, 0xf5, 0x47, 0xc8, 0x6d,
+		0x65, 0x46, 0x64, 0xae, 0xe7, 0x73, 0xf1, 0x41, 0x78, 0x30, 0x1c, 0xab, 0x41, 0xab, 0xef, 0x8c,
+		0x91, 0x4f, 0x6a, 0xf0, 0xd9, 0xed, 0xb6, 0xd5, 0xc4, 0x1a, 0x0c, 0x62, 0xc3, 0xb5, 0x35, 0xaa,
+		0x0a, 0xd2, 0xd7, 0x0f, 0xb7, 0xeb, 0xeb, 0x16, 0x6d, 0xa1, 0x1f, 0x9b, 0x12, 0x71, 0x74, 0x0e,
+		0xd3, 0xa0, 0x85, 0xff, 0x18, 0x83, 0x6c, 0x3b, 0x4e, 0x12, 0x15, 0x2c, 0xdb, 0x98, 0x66, 0x94,
+		0x42, 0xc1, 0xbc, 0x11, 0x91, 0xcd, 0x17, 0x99, 0x65, 0x20, 0x0e, 0x1b, 0x31, 0x2c, 0x42, 0xda,
+		0xb3, 0x87, 0x36, 0xe2, 0x33, 0x93, 0x62, 0x84, 0x61, 0x54, 0x33, 0x34, 0x57, 0x53, 0xf5, 0xd2,
+		0x96, 0xaa, 0xab, 0x46, 0x79, 0x3f, 0x9e, 0x6c, 0xf3, 0x4c, 0x3d, 0xc2, 0x41, 0x0b, 0x0c, 0x13,
+		0x5d, 0x85, 0x41, 0x01, 0x9f, 0x38, 0x00, 0x78, 0x01, 0x16, 0xf0, 0xda, 0xbe, 0x11, 0x83, 0x31,
+		0x05, 0x57, 0x5e, 0x5f, 0x6a, 0x7d, 0x2b, 0x00, 0x1b, 0x70, 0x64, 0x1e, 0xcc, 0x26, 0x0e, 0x60,
+		0x00, 0xa7, 0x18, 0xde, 0xbc, 0xe3, 0x06, 0x74, 0xfb, 0xd5, 0x18, 0xa4, 0x83, 0xba, 0x7d, 0x1d,
+		0xac, 0x0b, 0x68, 0xd1, 0x9f, 0x0d, 0x12, 0xfc, 0x33, 0xb9, 0x6d, 0x66, 0x83, 0x26, 0xab, 0xeb,
+		0x3c, 0x0d, 0xfc, 0x5c, 0x3f, 0x0c, 0xac, 0xa9, 0xb6, 0x5a, 0x73, 0xd0, 0xe5, 0x26, 0x87, 0x51,
+		0x44, 0xf5, 0x9a, 0x3e, 0x86, 0xce, 0x83, 0x08, 0xcc, 0xe4, 0xde, 0xdf, 0xc2, 0x5f, 0xbc, 0x17,
+		0x46, 0x88, 0xdf, 0x15, 0xb8, 0x00, 0x10, 0xa3, 0xc7, 0x9a, 0x64, 0x4f, 0x19, 0xb8, 0x6a, 0x39,
+		0x05, 0x43, 0x84, 0xcc, 0x9f, 0xe8, 0x08, 0x0d, 0xb9, 0xfa, 0x5a, 0x64, 0x39, 0xe8, 0x21, 0x40,
+		0x3b, 0x5e, 0x90, 0xa0, 0xe4, 0xab, 0x80, 0xd0, 0x8d, 0xf9, 0x25, 0x82, 0x9c, 0xc4, 0x12, 0x4d,
+		0xa3, 0x52, 0x62, 0x57, 0x9e, 0xd9, 0x9e, 0x2a, 0x45, 0x72, 0xe6, 0x49, 0x06, 0xfa, 0x19, 0xe6,
+		0x7b, 0x36, 0xec, 0x56, 0xb9, 0xdb, 0xbf, 0xd4, 0x9b, 0xa5, 0xfe, 0xf0, 0xd5, 0xa9, 0xdc, 0x9e,
+		0x5a, 0xd3, 0xf3, 0x72, 0x0b, 0x48, 0x99, 0xfa, 0xa2, 0xe1, 0x5d, 0x2e, 0x7a, 0x23, 0x0c, 0xf3,
+		0x43, 0x7a, 0x7a, 0x73, 0xd5, 0xa1, 0xff, 0x5b, 0x21, 0x55, 0xc8, 0xfe, 0xf0, 0xd5, 0xa9, 0x09,
+		0x86, 0x14, 0x2a, 0x96, 0x95, 0x21, 0x76, 0x4c, 0x4f, 0xef, 0x88, 0x21, 0x03, 0x46, 0x48, 0x45,
+		0x01, 0x1f, 0x96, 0x7e, 0xdf, 0xa8, 0xb0, 0xd0, 0xdb, 0x10, 0xfe, 0xe1, 0xab, 0x53, 0x87, 0x7c,
+		0xb1, 0x7d, 0x34, 0x59, 0x19, 0xae, 0x69, 0x46, 0x60, 0x35, 0xdc, 0x82, 0x1c, 0xbb, 0x1b, 0xfc,
+		0x02, 0xb6, 0xcd, 0x26, 0x77, 0x3d, 0x45, 0xbf, 0xc0, 0x7a, 0xef, 0x0f, 0x5f, 0x9d, 0x3a, 0xc6,
+		0xd0, 0xda, 0xd3, 0xca, 0xca, 0x1d, 0xb4, 0xf0, 0x19, 0x6c, 0x9b, 0x61, 0xef, 0x3c, 0x30, 0xa6,
+		0x3f, 0x2a, 0x01, 0xf2, 0x0b, 0x14, 0xec, 0x58, 0xa6, 0xe1, 0xd0, 0x6d, 0x47, 0xa0, 0x52, 0xa9,
+		0xf3, 0xb6, 0xc3, 0xe7, 0x17, 0xdb, 0x0e, 0x9f, 0x97, 0x7c, 0x36, 0x58, 0x4c, 0x7d, 0xb1, 0xa8,
+		0xeb, 0xd4, 0x7c, 0xc0, 0x34, 0xce, 0xea, 0x7d, 0xf2, 0x37, 0x24, 0x38, 0xd2, 0x34, 0xbe, 0x3c,
+		0x61, 0xff, 0x3f, 0x40, 0x76, 0xa0, 0x90, 0x7f, 0x01, 0x92, 0x09, 0xdd, 0xf3, 0x70, 0x1d, 0xb3,
+		0x1b, 0x0b, 0x6e, 0xd9, 0xaa, 0xc5, 0x6e, 0xf6, 0xff, 0x0b, 0x09, 0x26, 0x82, 0xc2, 0x78, 0xcd,
+		0x5a, 0x81, 0x74, 0x50, 0x16, 0xde, 0xa0, 0x7b, 0xba, 0x69, 0x10, 0x6f, 0x4b, 0x88, 0x1f, 0x3d,
+		0xe1, 0x4f, 0x65, 0x2c, 0x5c, 0xf7, 0x48, 0xd7, 0xba, 0x11, 0x32, 0x35, 0x4e, 0x69, 0x09, 0xda,
+		0x3b, 0x7f, 0x22, 0x41, 0x62, 0xcd, 0x34, 0x75, 0xb4, 0x03, 0x63, 0x86, 0xe9, 0x96, 0xc8, 0xb8,
+		0xc7, 0x95, 0xe0, 0x25, 0xfa, 0x9b, 0x55, 0xd9, 0xa8, 0x61, 0xba, 0x05, 0x8a, 0xca, 0x2f, 0xd0,
+		0xab, 0x30, 0x1c, 0xae, 0x25, 0x76, 0x00, 0xb5, 0xa4, 0xb7, 0x02, 0x55, 0xb0, 0xfb, 0x62, 0x3f,
+		0x78, 0x79, 0x4a, 0x3a, 0xf1, 0x79, 0x09, 0xc0, 0x0f, 0x8f, 0x90, 0x08, 0x7a, 0x61, 0x75, 0x65,
+		0xbe, 0xb4, 0xbe, 0x31, 0xbb, 0xb1, 0xb9, 0x1e, 0xbe, 0x34, 0x2e, 0xe2, 0xed, 0x8e, 0x85, 0xcb,
+		0xe4, 0x33, 0x6e, 0x15, 0x74, 0x1f, 0x4c, 0x84, 0xa9, 0x49, 0x8a, 0x7c, 0xa7, 0x35, 0x97, 0x7e,
+		0xe9, 0xc6, 0x74, 0x92, 0x79, 0x82, 0x98, 0xdc, 0x56, 0x38, 0xd4, 0x4c, 0x47, 0xbe, 0x42, 0x19,
+		0xcb, 0x0d, 0xbf, 0x74, 0x63, 0x3a, 0xe5, 0xb9, 0x8c, 0x48, 0x06, 0x14, 0xa4, 0xe4, 0x78, 0xf1,
+		0x1c, 0xbc, 0x74, 0x63, 0x7a, 0x80, 0x69, 0x29, 0x97, 0x20, 0x51, 0xf5, 0x03, 0xbf, 0x5a, 0xfe,
+		0x95, 0xc1, 0xb6, 0x61, 0xf4, 0x2a, 0x36, 0xb0, 0xa3, 0x39, 0xfb, 0x0a, 0xa3, 0x77, 0x15, 0x9a,
+		0xef, 0xf4, 0x9a, 0xe7, 0x2f, 0x13, 0x90, 0x5e, 0x60, 0x02, 0xac, 0xbb, 0x6c, 0xc6, 0x1f, 0xb0,
+		0xe8, 0xda, 0xea, 0x1d, 0xd9, 0xb5, 0xb1, 0x74, 0xb6, 0x02, 0x7b, 0xf7, 0xc6, 0x68, 0x0a, 0x3d,
+		0xc5, 0x2f, 0x8e, 0xb0, 0xe0, 0x85, 0x7f, 0x43, 0x2b, 0x5d, 0x98, 0xe9, 0xcd, 0xbe, 0xd8, 0x45,
+		0x13, 0x1a, 0xaa, 0x60, 0xd7, 0xcd, 0x2a, 0x70, 0x88, 0x22, 0xfb, 0x0e, 0x0a, 0x45, 0x17, 0x3b,
+		0x8d, 0x13, 0xed, 0xc4, 0x5c, 0x52, 0x1d, 0xff, 0xee, 0x08, 0x85, 0xe2, 0x22, 0x8f, 0xeb, 0x4d,
+		0x25, 0x0e, 0x5a, 0x08, 0x5d, 0x00, 0x4c, 0xf4, 0x16, 0x9a, 0x0f, 0xb0, 0xa2, 0xcb, 0x30, 0xe4,
+		0x4f, 0x04, 0x0e, 0xff, 0xe7, 0x33, 0xdd, 0x2f, 0x03, 0x41, 0x66, 0xb4, 0x0d, 0x87, 0x7c, 0x27,
+		0x27, 0x88, 0xca, 0xfe, 0x47, 0xcf, 0x83, 0x3d, 0x6c, 0xb2, 0x38, 0xfc, 0x44, 0xbd, 0xb9, 0x88,
+		0x6c, 0xdf, 0x86, 0x83, 0xb3, 0x9e, 0x93, 0x15, 0x9f, 0x99, 0xec, 0x7e, 0xda, 0x0c, 0x03, 0xb0,
+		0xff, 0x0b, 0x62, 0x99, 0xb6, 0x8b, 0x2b, 0xd9, 0x24, 0xff, 0x6e, 0x12, 0x4f, 0xcb, 0x3b, 0x80,
+		0x9a, 0xfb, 0x26, 0xfc, 0x90, 0x45, 0xea, 0xea, 0x21, 0x0b, 0x39, 0xcb, 0x0f, 0xde, 0x05, 0x64,
+		0x89, 0x7c, 0xf2, 0xdd, 0x7c, 0x09, 0x3c, 0xf0, 0xb1, 0xfc, 0xcd, 0x18, 0x9c, 0x08, 0x9e, 0x23,
+		0x3d, 0x57, 0xc7, 0xf6, 0x9e, 0x37, 0xf4, 0x2c, 0xb5, 0xaa, 0x19, 0xc1, 0xe7, 0x12, 0x47, 0x82,
+		0x8b, 0x36, 0xa5, 0x15, 0x1a, 0x94, 0xdf, 0x2d, 0xc1, 0xd0, 0x9a, 0x5a, 0xc5, 0x0a, 0x7e, 0xae,
+		0x8e, 0x1d, 0xb7, 0xc5, 0x75, 0x74, 0x72, 0x55, 0x7c, 0x7b, 0x5b, 0x1c, 0x7e, 0x27, 0x14, 0x9e,
+		0x22, 0x6d, 0xd6, 0x35, 0x72, 0x40, 0x1f, 0xa7, 0xd9, 0x2c, 0x41, 0x7c, 0xd3, 0xb2, 0x59, 0x37,
+		0xf8, 0xf8, 0xcb, 0x26, 0xc4, 0x97, 0x5e, 0xea, 0x06, 0x1b, 0x4a, 0x24, 0x7a, 0x6f, 0x63, 0x72,
+		0x49, 0x8d, 0x7d, 0xdb, 0x32, 0xa9, 0x88, 0xa4, 0xfc, 0x38, 0xa4, 0x99, 0x24, 0x7c, 0x09, 0x3d,
+		0x02, 0x49, 0x7a, 0x25, 0xcb, 0x97, 0x67, 0x90, 0xa4, 0xaf, 0xb0, 0x4b, 0xed, 0x0c, 0x9f, 0x89,
+		0xc4, 0x12, 0x85, 0x42, 0x5b, 0x2d, 0x1f, 0x8f, 0x1e, 0xf2, 0x4c, 0x87, 0x9e, 0x86, 0x7f, 0xb7,
+		0x1f, 0x0e, 0x31, 0x07, 0xfe, 0xa4, 0x6a, 0x69, 0x27, 0x77, 0x5c, 0x57, 0x3c, 0xb2, 0x00, 0x96,
+		0x3d, 0xa3, 0x5a, 0x9a, 0xbc, 0x07, 0x89, 0x4b, 0xae, 0x6b, 0xa1, 0x13, 0xd0, 0x6f, 0xd7, 0x75,
+		0x2c, 0x02, 0x4c, 0xde, 0x81, 0x80, 0x6a, 0x69, 0x33, 0x84, 0x40, 0xa9, 0xeb, 0x58, 0x61, 0x24,
+		0xa8, 0x08, 0x53, 0xdb, 0x75, 0x5d, 0xdf, 0x23, 0xff, 0xbe, 0xc9, 0xac, 0xe0, 0x92, 0xf7, 0xef,
+		0x2e, 0xf0, 0x75, 0x4b, 0x15, 0x1f, 0xcd, 0x24, 0x8a, 0xb9, 0x93, 0x92, 0xcd, 0x53, 0x2a, 0xf1,
+		0xaf, 0x2e, 0x8a, 0x82, 0x46, 0xfe, 0xa3, 0x18, 0x24, 0x05, 0x34, 0xb1, 0x72, 0x07, 0xeb, 0xb8,
+		0xec, 0x9a, 0xe2, 0x38, 0xc6, 0x4b, 0x23, 0x04, 0xf1, 0x2a, 0xef, 0xbc, 0xd4, 0xa5, 0x3e, 0x85,
+		0x24, 0x48, 0x9e, 0x77, 0xf7, 0x9f, 0xe4, 0x91, 0x27, 0x01, 0x13, 0x90, 0xb0, 0x4c, 0xb1, 0x03,
+		0xbd, 0xd4, 0xa7, 0xd0, 0x14, 0xca, 0xc2, 0x00, 0x19, 0x4e, 0x2e, 0xeb, 0x2d, 0x92, 0xcf, 0xd3,
+		0xe8, 0x30, 0x09, 0x51, 0xba, 0x65, 0x76, 0x2d, 0x8f, 0x14, 0xb0, 0x24, 0x3a, 0x0b, 0x03, 0xec,
+		0x4d, 0x78, 0xe3, 0x7f, 0xc2, 0x21, 0xca, 0x60, 0x1f, 0xdf, 0x23, 0x72, 0xaf, 0xa9, 0xae, 0x8b,
+		0x6d, 0x83, 0x00, 0x32, 0x72, 0x72, 0x75, 0x60, 0xcb, 0xac, 0xec, 0xf1, 0xff, 0xce, 0x43, 0x7f,
+		0xf3, 0x7f, 0x07, 0x42, 0xed, 0xa1, 0x44, 0x0b, 0xd9, 0x3f, 0x25, 0x4b, 0x8b, 0xcc, 0x02, 0x21,
+		0x2a, 0xc2, 0xb8, 0x5a, 0xa9, 0x68, 0xec, 0x1f, 0xe5, 0x94, 0xb6, 0x34, 0x3a, 0xad, 0x38, 0xd9,
+		0xa1, 0x0e, 0x7d, 0x81, 0x7c, 0x86, 0x02, 0xa7, 0x2f, 0xa4, 0xc8, 0x3f, 0xc7, 0xa3, 0x42, 0xc9,
+		0x17, 0x60, 0xac, 0x49, 0x52, 0x22, 0xdf, 0xae, 0x66, 0x54, 0xc4, 0x83, 0x08, 0xf2, 0x9b, 0xe4,
+		0xd1, 0xcf, 0x65, 0xb2, 0x83, 0x2e, 0xfa, 0xbb, 0xf0, 0xb3, 0xed, 0xdf, 0xcd, 0x8c, 0x04, 0xde,
+		0xcd, 0xa8, 0x96, 0x56, 0x48, 0x51, 0x7c, 0xfe, 0x5a, 0x66, 0xb6, 0xf9, 0xb5, 0x4c, 0x15, 0x1b,
+		0x62, 0xc1, 0x25, 0x45, 0xaa, 0xa5, 0x39, 0xd4, 0x1c, 0xfd, 0xcf, 0x77, 0x3a, 0x17, 0x02, 0xbf,
+		0xe9, 0xe3, 0x99, 0xc4, 0xc2, 0xec, 0xda, 0xa2, 0x67, 0xc7, 0x5f, 0x8a, 0xc1, 0x9d, 0x01, 0x3b,
+		0x0e, 0x10, 0x37, 0x9b, 0x73, 0xae, 0xb5, 0xc5, 0x77, 0xf1, 0x32, 0xfa, 0x0a, 0x24, 0x08, 0x3d,
+		0x8a, 0xf8, 0x67, 0x1d, 0xd9, 0x4f, 0x7f, 0xf5, 0x9f, 0xcb, 0xd3, 0x52, 0xdb, 0x5e, 0xa1, 0x20,
+		0x85, 0x77, 0x75, 0xaf, 0xbf, 0x8c, 0xff, 0xe5, 0x52, 0xe7, 0xe0, 0xd4, 0xd8, 0xa8, 0xc3, 0x8f,
+		0x15, 0xda, 0x3e, 0x7f, 0x65, 0x93, 0x69, 0x67, 0xbf, 0xa9, 0x87, 0x99, 0xba, 0xdd, 0x1b, 0x82,
+		0x4e, 0x3d, 0x78, 0xf3, 0x1e, 0xd8, 0x75, 0x38, 0xfc, 0x04, 0x11, 0xcb, 0x0f, 0x31, 0x88, 0xd5,
+		0xe0, 0xb0, 0x77, 0x00, 0x29, 0xf1, 0xc7, 0xe2, 0x34, 0x85, 0x2e, 0x02, 0xf8, 0xa2, 0xf3, 0xad,
+		0xe1, 0x7d, 0x33, 0x6d, 0x57, 0x99, 0x99, 0xc0, 0x0a, 0xa3, 0x04, 0x38, 0xe5, 0x4f, 0x4a, 0x70,
+		0x47, 0x53, 0xd5, 0x7c, 0xfa, 0x5f, 0x68, 0xf1, 0x12, 0x62, 0x5f, 0x8e, 0xd0, 0x42, 0x0b, 0x61,
+		0xef, 0x8f, 0x14, 0x96, 0x49, 0x11, 0x92, 0xf6, 0x29, 0x38, 0x14, 0x16, 0x56, 0xa8, 0xe9, 0x71,
+		0x18, 0x09, 0x07, 0xaf, 0x23, 0x3d, 0x87, 0xe1, 0x50, 0xe4, 0x5a, 0x2e, 0x35, 0xf6, 0x80, 0xa7,
+		0x85, 0x22, 0xa4, 0x3c, 0x52, 0xee, 0x0f, 0x77, 0xad, 0x04, 0x9f, 0x53, 0xae, 0xc0, 0xb1, 0x70,
+		0x05, 0xc5, 0xeb, 0x65, 0xef, 0x92, 0xc2, 0x81, 0x35, 0xe3, 0xe7, 0x25, 0x90, 0x3b, 0x55, 0xc3,
+		0xdb, 0xa4, 0xc2, 0x30, 0xbe, 0x1e, 0xbc, 0x77, 0x71, 0x10, 0xb7, 0x45, 0xd2, 0x38, 0x50, 0x95,
+		0xac, 0xc3, 0x03, 0x61, 0x41, 0xc2, 0x31, 0x14, 0x7e, 0x56, 0x7e, 0x50, 0xed, 0x7e, 0x2d, 0x06,
+		0x27, 0xba, 0xa9, 0x8e, 0xb7, 0xff, 0x59, 0x40, 0x34, 0xda, 0x73, 0xf0, 0x5b, 0xed, 0x0c, 0xc1,
+		0x0d, 0xed, 0xb5, 0xdb, 0x1c, 0x1c, 0xc7, 0x6e, 0xcd, 0xc1, 0xf1, 0x33, 0x90, 0x72, 0x76, 0x4c,
+		0xdb, 0xdd, 0x56, 0x75, 0xfd, 0x40, 0x82, 0xe5, 0x3e, 0x1c, 0x99, 0x2b, 0xa6, 0xc3, 0x4a, 0xf6,
+		0x2b, 0x3e, 0xb0, 0xae, 0x3c, 0xb0, 0x99, 0xed, 0xbb, 0x12, 0x1c, 0xeb, 0x20, 0x2d, 0xb7, 0x84,
+		0x17, 0x60, 0x22, 0x10, 0xfa, 0x12, 0x4e, 0x8d, 0x98, 0xed, 0x4e, 0x44, 0x6f, 0xd6, 0xbc, 0xd8,
+		0xce, 0x51, 0xa2, 0xe6, 0x4f, 0x7c, 0x73, 0x6a, 0xbc, 0xb9, 0xcc, 0x51, 0xc6, 0x9b, 0x03, 0x54,
+		0x07, 0x38, 0x2d, 0xbe, 0x22, 0x35, 0x0e, 0xb6, 0x16, 0xdb, 0xbe, 0xdb, 0xaf, 0x87, 0xbe, 0x21,
+		0xc1, 0x89, 0x6e, 0xc4, 0xe6, 0x5d, 0xb5, 0x05, 0xe3, 0xfe, 0x16, 0xb8, 0xb1, 0xa7, 0xf6, 0xb1,
+		0x01, 0x46, 0x1e, 0xda, 0x2d, 0xe8, 0x92, 0x8f, 0x49, 0x7c, 0x41, 0x09, 0x5a, 0x83, 0xa7, 0xff,
+		0xf0, 0xb1, 0x4f, 0xb4, 0xfe, 0x43, 0x67, 0x3e, 0x2d, 0x3a, 0x30, 0xd6, 0x53, 0x07, 0xfa, 0xdb,
+		0x62, 0xf9, 0x1a, 0xdc, 0xd1, 0x24, 0x25, 0x57, 0xf7, 0x5b, 0x61, 0xbc, 0xc5, 0xc8, 0xe0, 0x2b,
+		0x60, 0x0f, 0x03, 0x43, 0x41, 0xcd, 0xb6, 0x2f, 0xff, 0xba, 0x04, 0x53, 0xb4, 0xe2, 0x16, 0xdd,
+		0x73, 0x3b, 0xea, 0xa9, 0x06, 0xd3, 0xed, 0xc5, 0xe5, 0x0a, 0x5b, 0x84, 0x01, 0x66, 0x51, 0x5c,
+		0x47, 0xfb, 0x30, 0x49, 0x0e, 0x20, 0x7f, 0x4e, 0xcc, 0xb4, 0xf3, 0xa2, 0x41, 0xad, 0xc7, 0xf1,
+		0xcd, 0xe9, 0xe7, 0x80, 0xc6, 0x71, 0x40, 0x4d, 0x5f, 0x17, 0x73, 0x6e, 0x6b, 0xb9, 0xb9, 0xa2,
+		0xca, 0x07, 0x36, 0xe7, 0xf2, 0x28, 0xde, 0x2d, 0x9d, 0x5c, 0x7f, 0x47, 0x4c, 0xae, 0x5e, 0x9b,
+		0x22, 0x26, 0xd7, 0xdb, 0xad, 0x53, 0xbc, 0x69, 0x36, 0xa2, 0x01, 0x7f, 0x13, 0xa7, 0xd9, 0xdf,
+		0x89, 0xc1, 0x11, 0xda, 0xb6, 0x60, 0x1c, 0xf2, 0x20, 0x3b, 0x03, 0x91, 0x03, 0xf5, 0x1e, 0x67,
+		0x91, 0x8c, 0x63, 0x97, 0xaf, 0x36, 0xac, 0x98, 0xa8, 0xe2, 0xb8, 0x8d, 0x38, 0x51, 0x27, 0xea,
+		0x99, 0x4a, 0x20, 0x34, 0xda, 0xc2, 0x38, 0x12, 0x07, 0x60, 0x1c, 0x5f, 0x93, 0x20, 0xd7, 0x4a,
+		0x81, 0xdc, 0x18, 0x34, 0x38, 0x1c, 0x3a, 0x1b, 0x6c, 0xb4, 0x87, 0x37, 0x74, 0x13, 0x17, 0x6e,
+		0x18, 0xae, 0x87, 0x6c, 0x7c, 0xab, 0xbd, 0xa1, 0xa9, 0xb0, 0xbd, 0x37, 0x6f, 0xab, 0x6f, 0xc3,
+		0x61, 0xfa, 0x4a, 0xd3, 0x9c, 0xff, 0x37, 0x62, 0x4b, 0xfe, 0x29, 0x09, 0x26, 0xdb, 0x88, 0x7d,
+		0x3b, 0x2e, 0xe4, 0x3b, 0x6d, 0x6d, 0xe3, 0xa0, 0x37, 0xfc, 0x8f, 0xf1, 0x81, 0x15, 0x7e, 0x2c,
+		0x12, 0x88, 0xeb, 0xb4, 0x7a, 0x6d, 0x2a, 0x3f, 0x0d, 0x47, 0x5b, 0x72, 0x71, 0xd9, 0xf2, 0x90,
+		0x20, 0xb7, 0x47, 0xb2, 0x52, 0xd8, 0xe0, 0x1a, 0xc5, 0x6a, 0xe0, 0xa6, 0x3c, 0x32, 0x82, 0x0c,
+		0x85, 0x26, 0x67, 0xcd, 0x5c, 0x0c, 0xf9, 0x0a, 0x8c, 0x05, 0xf2, 0x78, 0x25, 0x67, 0x48, 0x1c,
+		0xda, 0xd4, 0xbd, 0x4f, 0x32, 0xb4, 0x3b, 0xfc, 0x33, 0x4d, 0x9d, 0x37, 0x9b, 0xd2, 0xcb, 0x13,
+		0x80, 0x18, 0x18, 0x3d, 0x07, 0x14, 0x55, 0xac, 0xc3, 0x78, 0x28, 0x97, 0x57, 0x72, 0x53, 0x67,
+		0x8c, 0xa7, 0xfe, 0xd5, 0x11, 0xe8, 0xa7, 0xa8, 0xe8, 0x03, 0x52, 0xe8, 0x23, 0x68, 0x33, 0xed,
+		0x60, 0x5a, 0xc7, 0xd7, 0x72, 0x27, 0xbb, 0xa6, 0xe7, 0x9e, 0xeb, 0x89, 0x9f, 0xfd, 0x77, 0xdf,
+		0x79, 0x5f, 0xec, 0x1e, 0x24, 0x9f, 0x6c, 0x13, 0xf4, 0x0b, 0x0c, 0xb2, 0x8f, 0x87, 0x3e, 0xd3,
+		0xf1, 0x50, 0x77, 0x55, 0x09, 0xc9, 0x66, 0xba, 0x25, 0xe7, 0x82, 0x5d, 0xa0, 0x82, 0x9d, 0x46,
+		0x8f, 0x46, 0x0b, 0x76, 0xf2, 0x6d, 0xe1, 0xe1, 0xf4, 0x76, 0xf4, 0x0d, 0x09, 0x0e, 0xb5, 0x0c,
+		0x19, 0xa1, 0xf3, 0xdd, 0x89, 0xd1, 0x22, 0x9a, 0x95, 0xcb, 0xef, 0x87, 0x95, 0xb7, 0x66, 0x91,
+		0xb6, 0x66, 0x0e, 0xcd, 0xee, 0xa3, 0x35, 0x27, 0x43, 0xb1, 0x2d, 0xf4, 0xbf, 0x25, 0xb8, 0xab,
+		0x63, 0x58, 0x08, 0xcd, 0x76, 0x27, 0x68, 0x87, 0x08, 0x56, 0xae, 0x70, 0x33, 0x10, 0xbc, 0xcd,
+		0x0a, 0x6d, 0xf3, 0x12, 0xba, 0xbc, 0x9f, 0x36, 0x37, 0xc4, 0x97, 0x4a, 0x3c, 0x4e, 0xfc, 0xef,
+		0x25, 0x98, 0x68, 0x15, 0x00, 0x41, 0xe7, 0xba, 0x13, 0xb8, 0xd9, 0xc5, 0xcd, 0x9d, 0xdf, 0x07,
+		0x27, 0x6f, 0xe1, 0x02, 0x6d, 0xe1, 0x2c, 0x7a, 0x7c, 0x3f, 0x2d, 0x0c, 0x1e, 0x2a, 0xff, 0xaf,
+		0x60, 0x9f, 0xb6, 0x72, 0x67, 0xbb, 0xed, 0xd3, 0x0e, 0xbe, 0x7c, 0xae, 0x70, 0x33, 0x10, 0xbc,
+		0xc5, 0x4f, 0xd0, 0x16, 0x5f, 0x41, 0x8b, 0xfb, 0x69, 0x71, 0xcb, 0x13, 0x7f, 0xf4, 0x7b, 0xe1,
+		0x8b, 0xf0, 0x9d, 0xe7, 0x89, 0xa6, 0x6d, 0x75, 0xee, 0x64, 0xd7, 0xf4, 0xbc, 0x09, 0x4f, 0xd1,
+		0x26, 0x28, 0x68, 0xed, 0x26, 0x3b, 0xed, 0xe4, 0xdb, 0xc2, 0x5e, 0xc0, 0xdb, 0xd1, 0x5f, 0x4a,
+		0xad, 0x6f, 0xb4, 0x9f, 0xed, 0x28, 0x62, 0xfb, 0x90, 0x41, 0xee, 0x5c, 0xef, 0x8c, 0xbc, 0x91,
+		0x35, 0xda, 0xc8, 0x2a, 0xc2, 0x07, 0xdd, 0xc8, 0x96, 0x9d, 0x88, 0xbe, 0x22, 0xc1, 0x44, 0xab,
+		0x3d, 0x72, 0xc4, 0xb0, 0xec, 0x10, 0x0e, 0x88, 0x18, 0x96, 0x9d, 0x36, 0xe4, 0xf2, 0x1b, 0x69,
+		0xe3, 0xcf, 0xa0, 0xc7, 0xda, 0x35, 0xbe, 0x63, 0x2f, 0x92, 0xb1, 0xd8, 0x71, 0x6b, 0x19, 0x31,
+		0x16, 0xbb, 0xd9, 0x57, 0x47, 0x8c, 0xc5, 0xae, 0x76, 0xb6, 0xd1, 0x63, 0xd1, 0x6b, 0x59, 0x97,
+		0xdd, 0xe8, 0xa0, 0x2f, 0x49, 0x30, 0x1c, 0xda, 0x39, 0xa1, 0x47, 0x3a, 0x0a, 0xda, 0x6a, 0x9b,
+		0x9a, 0x3b, 0xd5, 0x0b, 0x4b, 0xb7, 0xeb, 0x63, 0xa7, 0xb6, 0x84, 0x2f, 0xe8, 0x7c, 0x4d, 0x82,
+		0xf1, 0x16, 0x7b, 0x8e, 0x88, 0x51, 0xd8, 0x7e, 0x73, 0x95, 0x3b, 0xd7, 0x3b, 0x23, 0x6f, 0xd5,
+		0x45, 0xda, 0xaa, 0xb7, 0xa0, 0x37, 0xef, 0xa7, 0x55, 0x01, 0xc7, 0xeb, 0x55, 0xff, 0x5a, 0x6e,
+		0xa0, 0x1e, 0x74, 0xa6, 0x47, 0xc1, 0x44, 0x83, 0xce, 0xf6, 0xcc, 0xc7, 0xdb, 0xf3, 0x24, 0x6d,
+		0xcf, 0x13, 0x68, 0xf5, 0xe6, 0xda, 0xd3, 0xec, 0xaf, 0x7d, 0xb6, 0xf9, 0x15, 0x7a, 0x67, 0x2b,
+		0x6a, 0xb9, 0x0b, 0xc9, 0x3d, 0xda, 0x13, 0x0f, 0x6f, 0xd4, 0x39, 0xda, 0xa8, 0x53, 0xe8, 0xe1,
+		0x76, 0x8d, 0x0a, 0xdc, 0x7c, 0xd7, 0x8c, 0x6d, 0xf3, 0xe4, 0xdb, 0xd8, 0xde, 0xe6, 0xed, 0xe8,
+		0x1d, 0xe2, 0xa6, 0xeb, 0xf1, 0x8e, 0xf5, 0x06, 0x36, 0x28, 0xb9, 0x07, 0xba, 0xa0, 0xe4, 0x72,
+		0xdd, 0x43, 0xe5, 0x9a, 0x44, 0x77, 0xb6, 0x93, 0x8b, 0x6c, 0x52, 0xd0, 0x7b, 0x24, 0xef, 0xe1,
+		0xc0, 0x89, 0xce, 0xd8, 0xc1, 0x5d, 0x4c, 0xee, 0xc1, 0xae, 0x68, 0xb9, 0x24, 0xf7, 0x51, 0x49,
+		0xa6, 0xd1, 0x64, 0x5b, 0x49, 0xd8, 0x9e, 0xe6, 0xa0, 0x2f, 0xa5, 0xfd, 0xe6, 0x3d, 0x30, 0xd5,
+		0xa6, 0x46, 0xf7, 0x7a, 0xc4, 0x1d, 0x89, 0x0e, 0x1f, 0x63, 0x88, 0xfc, 0xd8, 0xc2, 0x41, 0x7f,
+		0x5e, 0xbc, 0xbb, 0x0b, 0x15, 0xf2, 0xe7, 0x12, 0x80, 0x96, 0x9d, 0xea, 0x9c, 0x8d, 0xd9, 0x7f,
+		0x49, 0xe6, 0xa3, 0xbc, 0xe1, 0xe1, 0xb2, 0x74, 0x53, 0x0f, 0x97, 0x97, 0x43, 0x0f, 0x88, 0x63,
+		0xbd, 0x7d, 0x1e, 0xa0, 0xeb, 0x57, 0xc4, 0xf1, 0x5b, 0x73, 0x18, 0xdc, 0xf2, 0x81, 0x51, 0xe2,
+		0x60, 0x5e, 0x06, 0xf6, 0xf7, 0xfc, 0x32, 0xf0, 0x22, 0x0c, 0xf0, 0x47, 0xfb, 0x03, 0xfb, 0x7a,
+		0xb4, 0xcf, 0xb9, 0xd1, 0x69, 0xf1, 0xe9, 0xec, 0xc1, 0xee, 0x1e, 0x44, 0x30, 0xea, 0x40, 0x0c,
+		0xe8, 0x4e, 0xc8, 0x35, 0x9b, 0x8d, 0x37, 0x78, 0xff, 0x3c, 0x0e, 0x99, 0x65, 0xa7, 0x5a, 0xac,
+		0x68, 0xee, 0x2d, 0xb2, 0xa9, 0x03, 0x7a, 0x69, 0xa9, 0xc2, 0x68, 0xe3, 0x8b, 0x20, 0x66, 0x47,
+		0xe7, 0xf6, 0x7d, 0x85, 0x63, 0x24, 0xfc, 0x15, 0x0b, 0xb4, 0xd3, 0xda, 0x5c, 0x13, 0x3d, 0x55,
+		0xd3, 0x95, 0xa9, 0x3e, 0xdb, 0xe6, 0xc1, 0x7b, 0xff, 0x4d, 0x56, 0xd5, 0xea, 0xb1, 0xbb, 0x6f,
+		0x09, 0x39, 0xc8, 0x36, 0x76, 0xb5, 0x67, 0x07, 0xaf, 0x4a, 0x30, 0xb4, 0xec, 0x08, 0x47, 0x12,
+		0xdf, 0x66, 0xcf, 0x6c, 0xcf, 0x7a, 0xff, 0x09, 0x23, 0xde, 0xdd, 0x28, 0xe0, 0xe4, 0x81, 0xc6,
+		0x1f, 0x82, 0xf1, 0x40, 0xfb, 0xbc, 0x76, 0xff, 0x56, 0x8c, 0xce, 0xaa, 0x05, 0x5c, 0xd5, 0x0c,
+		0xcf, 0xf7, 0xc4, 0xaf, 0x87, 0xc7, 0x8a, 0xbe, 0x4e, 0x13, 0xfb, 0xd5, 0xe9, 0x2e, 0xe4, 0x9a,
+		0x75, 0xe7, 0xc5, 0x3c, 0x5b, 0x3c, 0x8f, 0x95, 0xf6, 0xff, 0x3c, 0x56, 0xfe, 0x56, 0x0c, 0x8e,
+		0x34, 0xd7, 0xb6, 0x86, 0xed, 0x32, 0x36, 0x5e, 0x0f, 0x5f, 0x1d, 0x20, 0x2f, 0xcb, 0x2c, 0xd6,
+		0xda, 0x03, 0x79, 0xb5, 0x2b, 0xc0, 0x02, 0xfd, 0xf9, 0x49, 0x09, 0x8e, 0xb5, 0x55, 0xf1, 0x2d,
+		0xea, 0xd7, 0x80, 0x1d, 0xc6, 0x7a, 0xb2, 0x43, 0xf9, 0x5b, 0x12, 0x0c, 0x2f, 0x3b, 0xd5, 0x4d,
+		0xa3, 0xf2, 0xff, 0xec, 0xa4, 0xb5, 0x0d, 0x87, 0x42, 0x2d, 0xbc, 0x55, 0x63, 0x8b, 0x7c, 0x6c,
+		0x21, 0x54, 0x11, 0xff, 0x2a, 0xce, 0xeb, 0xe1, 0xb3, 0x13, 0xbe, 0xb6, 0x7f, 0x55, 0x82, 0xa3,
+		0x2d, 0xb4, 0x70, 0xdb, 0x19, 0xfe, 0xfb, 0x63, 0x70, 0x27, 0x71, 0xe9, 0xc8, 0xbb, 0x50, 0xfd,
+		0xf6, 0xff, 0x46, 0xc6, 0x7e, 0xc7, 0x41, 0xab, 0x0f, 0x31, 0x24, 0x5a, 0x7d, 0x88, 0x21, 0xd0,
+		0x85, 0xf7, 0xc1, 0x3d, 0x9d, 0x34, 0x23, 0xba, 0xf2, 0xd4, 0x27, 0x07, 0x21, 0xbe, 0xec, 0x54,
+		0xd1, 0x73, 0x30, 0xda, 0xb8, 0xa1, 0x6a, 0xbb, 0x4f, 0x6e, 0xf6, 0xa2, 0x73, 0xa7, 0xba, 0xa7,
+		0xf5, 0xac, 0x68, 0x17, 0x86, 0xc3, 0xde, 0xf6, 0xf1, 0x0e, 0x20, 0x21, 0xca, 0xdc, 0xc3, 0xdd,
+		0x52, 0x7a, 0x95, 0xfd, 0x14, 0x24, 0x79, 0xeb, 0x31, 0xba, 0xbb, 0x03, 0xb7, 0x20, 0xca, 0x3d,
+		0xd8, 0x05, 0x91, 0x87, 0xfe, 0x1c, 0x8c, 0x36, 0x3a, 0x4e, 0x9d, 0xb4, 0xd7, 0x40, 0x9b, 0x3b,
+		0xd5, 0x3d, 0xad, 0x57, 0xe5, 0xbb, 0x24, 0x38, 0xdc, 0xc6, 0x05, 0x78, 0xa4, 0x7b, 0x38, 0xce,
+		0x92, 0x3b, 0xdf, 0x33, 0x4b, 0xe0, 0xe2, 0x10, 0x04, 0x56, 0x9e, 0x7b, 0x3b, 0x00, 0xf9, 0x64,
+		0xb9, 0x87, 0xba, 0x22, 0xf3, 0xea, 0x70, 0x21, 0xd3, 0x34, 0x25, 0x3f, 0xd8, 0x15, 0x04, 0x23,
+		0xce, 0x3d, 0xda, 0x03, 0xb1, 0x57, 0xeb, 0x2f, 0x48, 0x70, 0xa4, 0xfd, 0xdc, 0xf2, 0x58, 0x27,
+		0x93, 0x6f, 0xc7, 0x95, 0x7b, 0xe3, 0x7e, 0xb8, 0xbc, 0x9b, 0x34, 0x07, 0x1c, 0x39, 0xfa, 0xbf,
+		0x03, 0x00, 0xa7, 0x34, 0xb8, 0xf0, 0x48, 0xa5, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2069,6 +2126,20 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if len(this.PubKeyTypes) != len(that1.PubKeyTypes) {
+		return false
+	}
+	for i := range this.PubKeyTypes {
+		if this.PubKeyTypes[i] != that1.PubKeyTypes[i] {
+			return false
+		}
+	}
+	if !this.MinDelegation.Equal(that1.MinDelegation) {
+		return false
+	}
+	if this.AllowZeroSelfDelegation != that1.AllowZeroSelfDelegation {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2344,6 +2415,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxTotalDelegation.Size()
+		i -= size
+		if _, err := m.MaxTotalDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	{
 		size := m.MinSelfDelegation.Size()
 		i -= size
@@ -2919,6 +3000,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowZeroSelfDelegation {
+		i--
+		if m.AllowZeroSelfDelegation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinDelegation.Size()
+		i -= size
+		if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.PubKeyTypes) > 0 {
+		for iNdEx := len(m.PubKeyTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeyTypes[iNdEx])
+			copy(dAtA[i:], m.PubKeyTypes[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKeyTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3258,6 +3368,8 @@ func (m *Validator) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxTotalDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3472,6 +3584,17 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.PubKeyTypes) > 0 {
+		for _, s := range m.PubKeyTypes {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.MinDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.AllowZeroSelfDelegation {
+		n += 2
+	}
 	return n
 }
 
@@ -4506,6 +4629,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6038,6 +6195,92 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyTypes = append(m.PubKeyTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowZeroSelfDelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowZeroSelfDelegation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
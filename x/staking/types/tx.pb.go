@@ -127,6 +127,8 @@ type MsgEditValidator struct {
 	// REF: #2373
 	CommissionRate    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty"`
 	MinSelfDelegation *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation,omitempty"`
+	// max_total_delegation is only updated when set. Zero removes the maximum.
+	MaxTotalDelegation *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_total_delegation,json=maxTotalDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_delegation,omitempty"`
 }
 
 func (m *MsgEditValidator) Reset()         { *m = MsgEditValidator{} }
@@ -364,6 +366,105 @@ func (m *MsgBeginRedelegateResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgBeginRedelegatePercent defines a SDK message for performing a
+// redelegation of a percentage of a delegation from a delegate and source
+// validator to a destination validator.
+type MsgBeginRedelegatePercent struct {
+	DelegatorAddress    string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	// percent is the fraction of the delegation shares to redelegate, in (0, 1].
+	Percent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=percent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percent"`
+}
+
+func (m *MsgBeginRedelegatePercent) Reset()         { *m = MsgBeginRedelegatePercent{} }
+func (m *MsgBeginRedelegatePercent) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginRedelegatePercent) ProtoMessage()    {}
+func (*MsgBeginRedelegatePercent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{8}
+}
+func (m *MsgBeginRedelegatePercent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginRedelegatePercent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginRedelegatePercent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBeginRedelegatePercent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginRedelegatePercent.Merge(m, src)
+}
+func (m *MsgBeginRedelegatePercent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginRedelegatePercent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginRedelegatePercent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginRedelegatePercent proto.InternalMessageInfo
+
+// MsgBeginRedelegatePercentResponse defines the Msg/BeginRedelegatePercent
+// response type.
+type MsgBeginRedelegatePercentResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// amount is the amount of tokens the redelegated shares were worth.
+	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgBeginRedelegatePercentResponse) Reset()         { *m = MsgBeginRedelegatePercentResponse{} }
+func (m *MsgBeginRedelegatePercentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginRedelegatePercentResponse) ProtoMessage()    {}
+func (*MsgBeginRedelegatePercentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{9}
+}
+func (m *MsgBeginRedelegatePercentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginRedelegatePercentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginRedelegatePercentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBeginRedelegatePercentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginRedelegatePercentResponse.Merge(m, src)
+}
+func (m *MsgBeginRedelegatePercentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginRedelegatePercentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginRedelegatePercentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginRedelegatePercentResponse proto.InternalMessageInfo
+
+func (m *MsgBeginRedelegatePercentResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgBeginRedelegatePercentResponse) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
 // MsgUndelegate defines a SDK message for performing an undelegation from a
 // delegate and a validator.
 type MsgUndelegate struct {
@@ -376,7 +477,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{8}
+	return fileDescriptor_0926ef28816b35ab, []int{10}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +515,7 @@ func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
 func (m *MsgUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegateResponse) ProtoMessage()    {}
 func (*MsgUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0926ef28816b35ab, []int{9}
+	return fileDescriptor_0926ef28816b35ab, []int{11}
 }
 func (m *MsgUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,6 +551,183 @@ func (m *MsgUndelegateResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgUndelegateShares defines a SDK message for performing an undelegation of
+// an exact amount of shares from a delegate and a validator.
+type MsgUndelegateShares struct {
+	DelegatorAddress string                                 `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string                                 `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Shares           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *MsgUndelegateShares) Reset()         { *m = MsgUndelegateShares{} }
+func (m *MsgUndelegateShares) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateShares) ProtoMessage()    {}
+func (*MsgUndelegateShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{12}
+}
+func (m *MsgUndelegateShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateShares.Merge(m, src)
+}
+func (m *MsgUndelegateShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateShares proto.InternalMessageInfo
+
+// MsgUndelegateSharesResponse defines the Msg/UndelegateShares response type.
+type MsgUndelegateSharesResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// amount is the amount of tokens the undelegated shares were worth.
+	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgUndelegateSharesResponse) Reset()         { *m = MsgUndelegateSharesResponse{} }
+func (m *MsgUndelegateSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateSharesResponse) ProtoMessage()    {}
+func (*MsgUndelegateSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{13}
+}
+func (m *MsgUndelegateSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateSharesResponse.Merge(m, src)
+}
+func (m *MsgUndelegateSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateSharesResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateSharesResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgUndelegateSharesResponse) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+// MsgCancelUnbondingDelegation defines a SDK message for cancelling an
+// unbonding delegation entry and delegating it back to the validator.
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is always less than or equal to the unbonding delegation entry balance.
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// creation_height is the height at which the unbonding took place.
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+}
+
+func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbondingDelegation{} }
+func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{14}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbondingDelegation proto.InternalMessageInfo
+
+// MsgCancelUnbondingDelegationResponse defines the
+// Msg/CancelUnbondingDelegation response type.
+type MsgCancelUnbondingDelegationResponse struct {
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Reset()         { *m = MsgCancelUnbondingDelegationResponse{} }
+func (m *MsgCancelUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegationResponse) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{15}
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegationResponse.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -459,66 +737,86 @@ func init() {
 	proto.RegisterType((*MsgDelegateResponse)(nil), "cosmos.staking.v1beta1.MsgDelegateResponse")
 	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegate")
 	proto.RegisterType((*MsgBeginRedelegateResponse)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegateResponse")
+	proto.RegisterType((*MsgBeginRedelegatePercent)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegatePercent")
+	proto.RegisterType((*MsgBeginRedelegatePercentResponse)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegatePercentResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.v1beta1.MsgUndelegate")
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateResponse")
+	proto.RegisterType((*MsgUndelegateShares)(nil), "cosmos.staking.v1beta1.MsgUndelegateShares")
+	proto.RegisterType((*MsgUndelegateSharesResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateSharesResponse")
+	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegation")
+	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 822 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8e, 0x43, 0xc8, 0xe5, 0x0e, 0xe2, 0x02, 0x06, 0xae, 0x82, 0x75, 0x95, 0xa0, 0x70, 0x7f,
-	0xd0, 0xbd, 0x37, 0x4e, 0xa1, 0xaa, 0x5a, 0x55, 0xdd, 0x10, 0x02, 0x12, 0xa2, 0x91, 0x2a, 0xd3,
-	0x76, 0x51, 0x55, 0x8a, 0xfc, 0x33, 0x31, 0x56, 0x6c, 0x8f, 0xf1, 0x4c, 0x10, 0x79, 0x83, 0x2e,
-	0x59, 0x77, 0xc5, 0x43, 0xd0, 0x4d, 0x9f, 0x00, 0x75, 0x85, 0x58, 0x55, 0x5d, 0x50, 0x04, 0x9b,
-	0xbe, 0x44, 0xa5, 0xca, 0xe3, 0xf1, 0xc4, 0xf9, 0xad, 0xa9, 0x60, 0xd3, 0x15, 0x91, 0xcf, 0x77,
-	0xbe, 0x33, 0xe7, 0x3b, 0xdf, 0x9c, 0x01, 0x14, 0x74, 0x84, 0x1d, 0x84, 0xcb, 0x98, 0xa8, 0x4d,
-	0xcb, 0x35, 0xcb, 0x07, 0xab, 0x1a, 0x24, 0xea, 0x6a, 0x99, 0x1c, 0xca, 0x9e, 0x8f, 0x08, 0x12,
-	0x7f, 0x0f, 0x01, 0x32, 0x03, 0xc8, 0x0c, 0x20, 0x2d, 0x9a, 0x08, 0x99, 0x36, 0x2c, 0x53, 0x94,
-	0xd6, 0x6a, 0x94, 0x55, 0xb7, 0x1d, 0xa6, 0x48, 0x85, 0xde, 0x10, 0xb1, 0x1c, 0x88, 0x89, 0xea,
-	0x78, 0x0c, 0x30, 0x6f, 0x22, 0x13, 0xd1, 0x9f, 0xe5, 0xe0, 0x17, 0xfb, 0xba, 0x18, 0x56, 0xaa,
-	0x87, 0x01, 0x56, 0x36, 0x0c, 0xe5, 0xd9, 0x29, 0x35, 0x15, 0x43, 0x7e, 0x44, 0x1d, 0x59, 0x2e,
-	0x8b, 0xff, 0x39, 0xa4, 0x8b, 0xe8, 0xd0, 0x14, 0x55, 0x7c, 0x97, 0x01, 0x62, 0x0d, 0x9b, 0x1b,
-	0x3e, 0x54, 0x09, 0x7c, 0xa9, 0xda, 0x96, 0xa1, 0x12, 0xe4, 0x8b, 0x3b, 0x60, 0xd2, 0x80, 0x58,
-	0xf7, 0x2d, 0x8f, 0x58, 0xc8, 0xcd, 0x09, 0x4b, 0xc2, 0xca, 0xe4, 0xda, 0xb2, 0x3c, 0xb8, 0x6f,
-	0xb9, 0xda, 0x81, 0x56, 0x32, 0xa7, 0x17, 0x85, 0x94, 0x12, 0xcf, 0x16, 0x6b, 0x00, 0xe8, 0xc8,
-	0x71, 0x2c, 0x8c, 0x03, 0xae, 0x34, 0xe5, 0xfa, 0x67, 0x18, 0xd7, 0x06, 0x47, 0x2a, 0x2a, 0x81,
-	0x98, 0xf1, 0xc5, 0x08, 0x44, 0x1b, 0xcc, 0x39, 0x96, 0x5b, 0xc7, 0xd0, 0x6e, 0xd4, 0x0d, 0x68,
-	0x43, 0x53, 0xa5, 0x67, 0x1c, 0x5b, 0x12, 0x56, 0x7e, 0xad, 0x3c, 0x09, 0xe0, 0x9f, 0x2e, 0x0a,
-	0x7f, 0x9b, 0x16, 0xd9, 0x6b, 0x69, 0xb2, 0x8e, 0x1c, 0x26, 0x1b, 0xfb, 0x53, 0xc2, 0x46, 0xb3,
-	0x4c, 0xda, 0x1e, 0xc4, 0xf2, 0xb6, 0x4b, 0xce, 0x4f, 0x4a, 0x80, 0x1d, 0x64, 0xdb, 0x25, 0xca,
-	0xac, 0x63, 0xb9, 0xbb, 0xd0, 0x6e, 0x54, 0x39, 0xad, 0xb8, 0x09, 0x66, 0x59, 0x11, 0xe4, 0xd7,
-	0x55, 0xc3, 0xf0, 0x21, 0xc6, 0xb9, 0x0c, 0xad, 0x95, 0x3b, 0x3f, 0x29, 0xcd, 0xb3, 0xec, 0xf5,
-	0x30, 0xb2, 0x4b, 0x7c, 0xcb, 0x35, 0x95, 0x19, 0x9e, 0xc2, 0xbe, 0x07, 0x34, 0x07, 0x91, 0xba,
-	0x9c, 0x66, 0xfc, 0x7b, 0x34, 0x3c, 0x25, 0xa2, 0xd9, 0x02, 0x59, 0xaf, 0xa5, 0x35, 0x61, 0x3b,
-	0x97, 0xa5, 0x32, 0xce, 0xcb, 0xa1, 0xaf, 0xe4, 0xc8, 0x57, 0xf2, 0xba, 0xdb, 0xae, 0xe4, 0x3e,
-	0x74, 0x18, 0x75, 0xbf, 0xed, 0x11, 0x24, 0x3f, 0x6b, 0x69, 0x3b, 0xb0, 0xad, 0xb0, 0x6c, 0xf1,
-	0x01, 0x18, 0x3f, 0x50, 0xed, 0x16, 0xcc, 0xfd, 0x42, 0x69, 0x16, 0xa3, 0x69, 0x04, 0x66, 0x8a,
-	0x8d, 0xc2, 0x8a, 0xe6, 0x19, 0xa2, 0x1f, 0x4f, 0xbc, 0x39, 0x2e, 0xa4, 0xbe, 0x1c, 0x17, 0x52,
-	0xc5, 0x3f, 0x80, 0xd4, 0x6f, 0x1b, 0x05, 0x62, 0x0f, 0xb9, 0x18, 0x16, 0xbf, 0xa6, 0xc1, 0x4c,
-	0x0d, 0x9b, 0x9b, 0x86, 0x45, 0xee, 0xc8, 0x53, 0x03, 0xf5, 0x4c, 0xdf, 0x58, 0x4f, 0x15, 0x4c,
-	0x77, 0x9c, 0x55, 0xf7, 0x55, 0x02, 0x99, 0x8f, 0x1e, 0x25, 0xf4, 0x50, 0x15, 0xea, 0x31, 0x0f,
-	0x55, 0xa1, 0xae, 0xfc, 0xa6, 0x77, 0x39, 0x58, 0xdc, 0x1b, 0x6c, 0xd7, 0xcc, 0x8d, 0xca, 0x24,
-	0xb1, 0x6a, 0x6c, 0x3a, 0x12, 0xc8, 0xf5, 0xca, 0xcf, 0x67, 0x73, 0x21, 0x80, 0xc9, 0x1a, 0x36,
-	0x59, 0x1e, 0x1c, 0x6c, 0x70, 0xe1, 0x76, 0x0c, 0x7e, 0xf3, 0x81, 0x3c, 0x04, 0x59, 0xd5, 0x41,
-	0x2d, 0x97, 0xd0, 0x39, 0x24, 0x70, 0x26, 0x83, 0xc7, 0x9a, 0x5f, 0x00, 0x73, 0xb1, 0xfe, 0x78,
-	0xdf, 0xef, 0xd3, 0x74, 0xd3, 0x55, 0xa0, 0x69, 0xb9, 0x0a, 0x34, 0x6e, 0xb9, 0xfd, 0xa7, 0x60,
-	0xa1, 0xd3, 0x3e, 0xf6, 0xf5, 0xc4, 0x12, 0xcc, 0xf1, 0xb4, 0x5d, 0x5f, 0x1f, 0xc8, 0x66, 0x60,
-	0xc2, 0xd9, 0xc6, 0x12, 0xb3, 0x55, 0x31, 0xe9, 0xd7, 0x34, 0xf3, 0xa3, 0x9a, 0x36, 0xe9, 0x75,
-	0xef, 0xd1, 0x2e, 0x92, 0x56, 0xac, 0xd1, 0x5b, 0xe4, 0xd9, 0x30, 0xb0, 0x61, 0x3d, 0x78, 0xd9,
-	0xd8, 0xed, 0x96, 0xfa, 0xd6, 0xd3, 0xf3, 0xe8, 0xd9, 0xab, 0x4c, 0x04, 0xa5, 0x8e, 0x3e, 0x17,
-	0x04, 0x7a, 0x63, 0x58, 0x72, 0x10, 0x2e, 0x5e, 0x0a, 0x60, 0xaa, 0x86, 0xcd, 0x17, 0xae, 0xf1,
-	0xd3, 0x7a, 0xb4, 0x01, 0x16, 0xba, 0x3a, 0xbc, 0x23, 0x29, 0xd7, 0xde, 0x66, 0xc0, 0x58, 0x0d,
-	0x9b, 0xe2, 0x3e, 0x98, 0xee, 0x7d, 0xe2, 0xff, 0x1d, 0xb6, 0x79, 0xfb, 0xf7, 0xba, 0xb4, 0x96,
-	0x1c, 0xcb, 0x3b, 0x69, 0x82, 0xa9, 0xee, 0xfd, 0xbf, 0x32, 0x82, 0xa4, 0x0b, 0x29, 0xdd, 0x4b,
-	0x8a, 0xe4, 0xc5, 0x5e, 0x83, 0x09, 0xbe, 0xd0, 0x96, 0x47, 0x64, 0x47, 0x20, 0xe9, 0xbf, 0x04,
-	0x20, 0xce, 0xbe, 0x0f, 0xa6, 0x7b, 0xd7, 0xc6, 0x28, 0xf5, 0x7a, 0xb0, 0x23, 0xd5, 0x1b, 0x76,
-	0xa5, 0x34, 0x00, 0x62, 0xfe, 0xff, 0x6b, 0x04, 0x43, 0x07, 0x26, 0x95, 0x12, 0xc1, 0xa2, 0x1a,
-	0x95, 0xad, 0xd3, 0xab, 0xbc, 0x70, 0x76, 0x95, 0x17, 0x2e, 0xaf, 0xf2, 0xc2, 0xd1, 0x75, 0x3e,
-	0x75, 0x76, 0x9d, 0x4f, 0x7d, 0xbc, 0xce, 0xa7, 0x5e, 0xfd, 0x3f, 0xf2, 0x49, 0x3a, 0xe4, 0xff,
-	0x53, 0xd2, 0xc7, 0x49, 0xcb, 0x52, 0x4b, 0xde, 0xff, 0x16, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xd5,
-	0xae, 0x16, 0x38, 0x0b, 0x00, 0x00,
+	// 1046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x16, 0x25, 0xf9, 0xe3, 0x1d, 0x23, 0xb6, 0x43, 0xdb, 0x01, 0xcd, 0x37, 0x90, 0x52, 0x25,
+	0x4d, 0x8c, 0xa6, 0xa6, 0x6a, 0xa7, 0x45, 0x3f, 0x90, 0x4b, 0x14, 0x25, 0x68, 0x90, 0x0a, 0x08,
+	0x68, 0x27, 0x87, 0xa2, 0x80, 0xb0, 0x22, 0xd7, 0x14, 0x2b, 0x92, 0xab, 0x70, 0x57, 0x86, 0x75,
+	0x2e, 0x50, 0xf4, 0xd6, 0x1c, 0x7b, 0xcc, 0xa5, 0xa7, 0x5c, 0xd3, 0x4b, 0x7f, 0x41, 0xd0, 0x53,
+	0x90, 0x53, 0xd1, 0x83, 0x6b, 0xd8, 0x97, 0xf6, 0x5f, 0x14, 0x24, 0x97, 0x2b, 0xea, 0x33, 0x54,
+	0x6a, 0x03, 0x69, 0x7b, 0x32, 0xb1, 0xfb, 0xcc, 0x33, 0x3b, 0x33, 0xcf, 0xce, 0x8e, 0x05, 0x45,
+	0x83, 0x50, 0x97, 0xd0, 0x32, 0x65, 0xa8, 0x65, 0x7b, 0x56, 0x79, 0x7f, 0xab, 0x81, 0x19, 0xda,
+	0x2a, 0xb3, 0x03, 0xad, 0xed, 0x13, 0x46, 0xe4, 0x0b, 0x11, 0x40, 0xe3, 0x00, 0x8d, 0x03, 0xd4,
+	0x75, 0x8b, 0x10, 0xcb, 0xc1, 0xe5, 0x10, 0xd5, 0xe8, 0xec, 0x95, 0x91, 0xd7, 0x8d, 0x4c, 0xd4,
+	0xe2, 0xe0, 0x16, 0xb3, 0x5d, 0x4c, 0x19, 0x72, 0xdb, 0x1c, 0xb0, 0x6a, 0x11, 0x8b, 0x84, 0x9f,
+	0xe5, 0xe0, 0x8b, 0xaf, 0xae, 0x47, 0x9e, 0xea, 0xd1, 0x06, 0x77, 0x1b, 0x6d, 0x15, 0xf8, 0x29,
+	0x1b, 0x88, 0x62, 0x71, 0x44, 0x83, 0xd8, 0x1e, 0xdf, 0xbf, 0x32, 0x26, 0x8a, 0xf8, 0xd0, 0x21,
+	0xaa, 0xf4, 0x53, 0x1e, 0xe4, 0x1a, 0xb5, 0x6e, 0xfb, 0x18, 0x31, 0xfc, 0x08, 0x39, 0xb6, 0x89,
+	0x18, 0xf1, 0xe5, 0xfb, 0xb0, 0x60, 0x62, 0x6a, 0xf8, 0x76, 0x9b, 0xd9, 0xc4, 0x53, 0xa4, 0x4b,
+	0xd2, 0xc6, 0xc2, 0xf6, 0x65, 0x6d, 0x74, 0xdc, 0x5a, 0xb5, 0x07, 0xad, 0xe4, 0x5f, 0x1c, 0x16,
+	0x33, 0x7a, 0xd2, 0x5a, 0xae, 0x01, 0x18, 0xc4, 0x75, 0x6d, 0x4a, 0x03, 0xae, 0x6c, 0xc8, 0x75,
+	0x6d, 0x1c, 0xd7, 0x6d, 0x81, 0xd4, 0x11, 0xc3, 0x94, 0xf3, 0x25, 0x08, 0x64, 0x07, 0x56, 0x5c,
+	0xdb, 0xab, 0x53, 0xec, 0xec, 0xd5, 0x4d, 0xec, 0x60, 0x0b, 0x85, 0x67, 0xcc, 0x5d, 0x92, 0x36,
+	0xfe, 0x57, 0xb9, 0x19, 0xc0, 0x7f, 0x3b, 0x2c, 0x5e, 0xb5, 0x6c, 0xd6, 0xec, 0x34, 0x34, 0x83,
+	0xb8, 0x3c, 0x6d, 0xfc, 0xcf, 0x26, 0x35, 0x5b, 0x65, 0xd6, 0x6d, 0x63, 0xaa, 0xdd, 0xf3, 0xd8,
+	0xab, 0xe7, 0x9b, 0xc0, 0x0f, 0x72, 0xcf, 0x63, 0xfa, 0x79, 0xd7, 0xf6, 0x76, 0xb0, 0xb3, 0x57,
+	0x15, 0xb4, 0xf2, 0x1d, 0x38, 0xcf, 0x9d, 0x10, 0xbf, 0x8e, 0x4c, 0xd3, 0xc7, 0x94, 0x2a, 0xf9,
+	0xd0, 0x97, 0xf2, 0xea, 0xf9, 0xe6, 0x2a, 0xb7, 0xbe, 0x15, 0xed, 0xec, 0x30, 0xdf, 0xf6, 0x2c,
+	0x7d, 0x59, 0x98, 0xf0, 0xf5, 0x80, 0x66, 0x3f, 0xce, 0xae, 0xa0, 0x99, 0x79, 0x1d, 0x8d, 0x30,
+	0x89, 0x69, 0xee, 0xc2, 0x6c, 0xbb, 0xd3, 0x68, 0xe1, 0xae, 0x32, 0x1b, 0xa6, 0x71, 0x55, 0x8b,
+	0x74, 0xa5, 0xc5, 0xba, 0xd2, 0x6e, 0x79, 0xdd, 0x8a, 0xf2, 0x4b, 0x8f, 0xd1, 0xf0, 0xbb, 0x6d,
+	0x46, 0xb4, 0x07, 0x9d, 0xc6, 0x7d, 0xdc, 0xd5, 0xb9, 0xb5, 0xfc, 0x11, 0xcc, 0xec, 0x23, 0xa7,
+	0x83, 0x95, 0xb9, 0x90, 0x66, 0x3d, 0xae, 0x46, 0x20, 0xa6, 0x44, 0x29, 0xec, 0xb8, 0x9e, 0x11,
+	0xfa, 0xb3, 0xf9, 0xef, 0x9e, 0x16, 0x33, 0x7f, 0x3c, 0x2d, 0x66, 0x4a, 0x17, 0x41, 0x1d, 0x96,
+	0x8d, 0x8e, 0x69, 0x9b, 0x78, 0x14, 0x97, 0xfe, 0xcc, 0xc1, 0x72, 0x8d, 0x5a, 0x77, 0x4c, 0x9b,
+	0x9d, 0x91, 0xa6, 0x46, 0xe6, 0x33, 0x3b, 0x75, 0x3e, 0x11, 0x2c, 0xf5, 0x94, 0x55, 0xf7, 0x11,
+	0xc3, 0x5c, 0x47, 0x9f, 0xa4, 0xd4, 0x50, 0x15, 0x1b, 0x09, 0x0d, 0x55, 0xb1, 0xa1, 0x2f, 0x1a,
+	0x7d, 0x0a, 0x96, 0x9b, 0xa3, 0xe5, 0x9a, 0x9f, 0xca, 0x4d, 0x2a, 0xa9, 0x7e, 0x0d, 0xab, 0x2e,
+	0x3a, 0xa8, 0x33, 0xc2, 0x90, 0x93, 0x74, 0x35, 0xf3, 0x37, 0x5d, 0xc9, 0x2e, 0x3a, 0xd8, 0x0d,
+	0x48, 0x7b, 0xbe, 0x12, 0x4a, 0x50, 0x41, 0x19, 0x2c, 0xb5, 0xd0, 0xc1, 0xa1, 0x04, 0x0b, 0x35,
+	0x6a, 0x71, 0x3b, 0x3c, 0xfa, 0x32, 0x49, 0xa7, 0x73, 0x99, 0xa6, 0x2f, 0xfe, 0xc7, 0x30, 0x8b,
+	0x5c, 0xd2, 0xf1, 0x98, 0x92, 0x4b, 0x77, 0x0b, 0x38, 0x3c, 0x11, 0xfc, 0x1a, 0xac, 0x24, 0xe2,
+	0x13, 0x71, 0xff, 0x9c, 0x0d, 0xbb, 0x6a, 0x05, 0x5b, 0xb6, 0xa7, 0x63, 0xf3, 0x94, 0xc3, 0xff,
+	0x02, 0xd6, 0x7a, 0xe1, 0x53, 0xdf, 0x48, 0x9d, 0x82, 0x15, 0x61, 0xb6, 0xe3, 0x1b, 0x23, 0xd9,
+	0x4c, 0xca, 0x04, 0x5b, 0x2e, 0x35, 0x5b, 0x95, 0xb2, 0xe1, 0x9c, 0xe6, 0xdf, 0x34, 0xa7, 0x2d,
+	0x50, 0x87, 0x73, 0x17, 0xa7, 0x56, 0xae, 0x85, 0x37, 0xb6, 0xed, 0xe0, 0x40, 0x86, 0xf5, 0xe0,
+	0x15, 0xe5, 0x9d, 0x44, 0x1d, 0x6a, 0x85, 0xbb, 0xf1, 0x13, 0x5b, 0x99, 0x0f, 0x5c, 0x3d, 0xf9,
+	0xbd, 0x28, 0xe9, 0x8b, 0x3d, 0xe3, 0x60, 0xbb, 0x74, 0x94, 0x85, 0xf5, 0x61, 0x6f, 0x0f, 0xb0,
+	0x6f, 0x60, 0x8f, 0xfd, 0x17, 0x0a, 0xf6, 0x08, 0xe6, 0xda, 0x51, 0xb4, 0x4a, 0x7e, 0xea, 0x17,
+	0x74, 0xb8, 0xfb, 0xc5, 0x64, 0x89, 0x7a, 0x3e, 0x93, 0xe0, 0x9d, 0xb1, 0x29, 0x3e, 0xa3, 0xba,
+	0x26, 0x74, 0x98, 0x9d, 0x4a, 0x87, 0xa5, 0x23, 0x09, 0xce, 0xd5, 0xa8, 0xf5, 0xd0, 0x33, 0xff,
+	0xb5, 0x4d, 0x6b, 0x0f, 0xd6, 0xfa, 0x22, 0x3c, 0xab, 0xbb, 0xf5, 0x4d, 0x16, 0x56, 0xfa, 0x1c,
+	0xed, 0x34, 0x91, 0x8f, 0xe9, 0x5b, 0x96, 0xd0, 0x5d, 0x98, 0xa5, 0xe1, 0xb9, 0x94, 0xdc, 0x29,
+	0xe8, 0x9f, 0x73, 0x25, 0xb2, 0xfd, 0xa3, 0x04, 0xff, 0x1f, 0x91, 0x85, 0xb7, 0x4e, 0xf8, 0x3f,
+	0x64, 0xe1, 0x62, 0x30, 0xd2, 0x21, 0xcf, 0xc0, 0xce, 0x43, 0xaf, 0x41, 0x3c, 0xd3, 0xf6, 0xac,
+	0xd7, 0x4d, 0xc2, 0xff, 0xb8, 0x7b, 0x20, 0x5f, 0x83, 0x25, 0xc3, 0xc7, 0x61, 0x48, 0xf5, 0x26,
+	0xb6, 0xad, 0x66, 0xd4, 0xf8, 0x72, 0xfa, 0x62, 0xbc, 0xfc, 0x79, 0xb8, 0x9a, 0x28, 0xe1, 0x55,
+	0xb8, 0x32, 0x29, 0x33, 0x71, 0x29, 0xb7, 0x9f, 0xcd, 0x41, 0xae, 0x46, 0x2d, 0xf9, 0x31, 0x2c,
+	0x0d, 0xfe, 0x43, 0xf5, 0xde, 0xb8, 0x39, 0x77, 0x78, 0x8a, 0x56, 0xb7, 0xd3, 0x63, 0x85, 0x8a,
+	0x5a, 0x70, 0xae, 0x7f, 0xda, 0xde, 0x98, 0x40, 0xd2, 0x87, 0x54, 0x3f, 0x48, 0x8b, 0x14, 0xce,
+	0xbe, 0x82, 0x79, 0x31, 0xd2, 0x5d, 0x9e, 0x60, 0x1d, 0x83, 0xd4, 0xeb, 0x29, 0x40, 0x82, 0xfd,
+	0x31, 0x2c, 0x0d, 0x0e, 0x4e, 0x93, 0xb2, 0x37, 0x80, 0x55, 0xb7, 0xd3, 0x63, 0x85, 0xcb, 0x6f,
+	0x25, 0xb8, 0x30, 0x66, 0x04, 0xd8, 0x4a, 0x4f, 0xc7, 0x4d, 0xd4, 0x4f, 0xa7, 0x36, 0x11, 0x07,
+	0x69, 0x00, 0x24, 0x5e, 0x9e, 0x77, 0x27, 0x10, 0xf5, 0x60, 0xea, 0x66, 0x2a, 0x98, 0xf0, 0xc1,
+	0x60, 0x79, 0xa8, 0x25, 0x5f, 0x4f, 0x45, 0x11, 0x81, 0xd5, 0x1b, 0x53, 0x80, 0x85, 0xd7, 0xef,
+	0x25, 0x58, 0x1f, 0xdf, 0x5b, 0x3e, 0x9c, 0x24, 0xf9, 0x71, 0x56, 0xea, 0xcd, 0x37, 0xb1, 0x8a,
+	0x4f, 0x54, 0xb9, 0xfb, 0xe2, 0xb8, 0x20, 0xbd, 0x3c, 0x2e, 0x48, 0x47, 0xc7, 0x05, 0xe9, 0xc9,
+	0x49, 0x21, 0xf3, 0xf2, 0xa4, 0x90, 0xf9, 0xf5, 0xa4, 0x90, 0xf9, 0xf2, 0xfd, 0x89, 0xad, 0xff,
+	0x40, 0xfc, 0xa4, 0x12, 0x3e, 0x02, 0x8d, 0xd9, 0xb0, 0x3f, 0xdf, 0xf8, 0x6b, 0x00, 0xca, 0xbc,
+	0x5a, 0x36, 0x37, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -543,9 +841,19 @@ type MsgClient interface {
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate, opts ...grpc.CallOption) (*MsgBeginRedelegateResponse, error)
+	// BeginRedelegatePercent defines a method for performing a redelegation of
+	// a percentage of a delegation from a source validator to a destination
+	// validator.
+	BeginRedelegatePercent(ctx context.Context, in *MsgBeginRedelegatePercent, opts ...grpc.CallOption) (*MsgBeginRedelegatePercentResponse, error)
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// UndelegateShares defines a method for performing an undelegation of an
+	// exact amount of shares from a delegate and a validator.
+	UndelegateShares(ctx context.Context, in *MsgUndelegateShares, opts ...grpc.CallOption) (*MsgUndelegateSharesResponse, error)
+	// CancelUnbondingDelegation defines a method for cancelling an unbonding
+	// delegation entry and delegating its balance back to the validator.
+	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
 }
 
 type msgClient struct {
@@ -592,6 +900,15 @@ func (c *msgClient) BeginRedelegate(ctx context.Context, in *MsgBeginRedelegate,
 	return out, nil
 }
 
+func (c *msgClient) BeginRedelegatePercent(ctx context.Context, in *MsgBeginRedelegatePercent, opts ...grpc.CallOption) (*MsgBeginRedelegatePercentResponse, error) {
+	out := new(MsgBeginRedelegatePercentResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/BeginRedelegatePercent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
 	out := new(MsgUndelegateResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/Undelegate", in, out, opts...)
@@ -601,6 +918,24 @@ func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UndelegateShares(ctx context.Context, in *MsgUndelegateShares, opts ...grpc.CallOption) (*MsgUndelegateSharesResponse, error) {
+	out := new(MsgUndelegateSharesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/UndelegateShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error) {
+	out := new(MsgCancelUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -613,9 +948,19 @@ type MsgServer interface {
 	// BeginRedelegate defines a method for performing a redelegation
 	// of coins from a delegator and source validator to a destination validator.
 	BeginRedelegate(context.Context, *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error)
+	// BeginRedelegatePercent defines a method for performing a redelegation of
+	// a percentage of a delegation from a source validator to a destination
+	// validator.
+	BeginRedelegatePercent(context.Context, *MsgBeginRedelegatePercent) (*MsgBeginRedelegatePercentResponse, error)
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	// UndelegateShares defines a method for performing an undelegation of an
+	// exact amount of shares from a delegate and a validator.
+	UndelegateShares(context.Context, *MsgUndelegateShares) (*MsgUndelegateSharesResponse, error)
+	// CancelUnbondingDelegation defines a method for cancelling an unbonding
+	// delegation entry and delegating its balance back to the validator.
+	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -634,9 +979,18 @@ func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (
 func (*UnimplementedMsgServer) BeginRedelegate(ctx context.Context, req *MsgBeginRedelegate) (*MsgBeginRedelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginRedelegate not implemented")
 }
+func (*UnimplementedMsgServer) BeginRedelegatePercent(ctx context.Context, req *MsgBeginRedelegatePercent) (*MsgBeginRedelegatePercentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginRedelegatePercent not implemented")
+}
 func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
+func (*UnimplementedMsgServer) UndelegateShares(ctx context.Context, req *MsgUndelegateShares) (*MsgUndelegateSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateShares not implemented")
+}
+func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, req *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -714,6 +1068,24 @@ func _Msg_BeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BeginRedelegatePercent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginRedelegatePercent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginRedelegatePercent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/BeginRedelegatePercent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginRedelegatePercent(ctx, req.(*MsgBeginRedelegatePercent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUndelegate)
 	if err := dec(in); err != nil {
@@ -732,6 +1104,42 @@ func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UndelegateShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/UndelegateShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateShares(ctx, req.(*MsgUndelegateShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbondingDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, req.(*MsgCancelUnbondingDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -752,10 +1160,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BeginRedelegate",
 			Handler:    _Msg_BeginRedelegate_Handler,
 		},
+		{
+			MethodName: "BeginRedelegatePercent",
+			Handler:    _Msg_BeginRedelegatePercent_Handler,
+		},
 		{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
 		},
+		{
+			MethodName: "UndelegateShares",
+			Handler:    _Msg_UndelegateShares_Handler,
+		},
+		{
+			MethodName: "CancelUnbondingDelegation",
+			Handler:    _Msg_CancelUnbondingDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -893,6 +1313,18 @@ func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTotalDelegation != nil {
+		{
+			size := m.MaxTotalDelegation.Size()
+			i -= size
+			if _, err := m.MaxTotalDelegation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.MinSelfDelegation != nil {
 		{
 			size := m.MinSelfDelegation.Size()
@@ -1115,7 +1547,7 @@ func (m *MsgBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgBeginRedelegatePercent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1125,30 +1557,37 @@ func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBeginRedelegatePercent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBeginRedelegatePercent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Percent.Size()
+		i -= size
+		if _, err := m.Percent.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+	dAtA[i] = 0x22
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1162,7 +1601,7 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBeginRedelegatePercentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1172,18 +1611,28 @@ func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBeginRedelegatePercentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBeginRedelegatePercentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
 		return 0, err10
 	}
 	i -= n10
@@ -1193,6 +1642,247 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1262,6 +1952,10 @@ func (m *MsgEditValidator) Size() (n int) {
 		l = m.MinSelfDelegation.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MaxTotalDelegation != nil {
+		l = m.MaxTotalDelegation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1336,7 +2030,7 @@ func (m *MsgBeginRedelegateResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUndelegate) Size() (n int) {
+func (m *MsgBeginRedelegatePercent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1346,16 +2040,20 @@ func (m *MsgUndelegate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ValidatorAddress)
+	l = len(m.ValidatorSrcAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Amount.Size()
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Percent.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgUndelegateResponse) Size() (n int) {
+func (m *MsgBeginRedelegatePercentResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1363,16 +2061,717 @@ func (m *MsgUndelegateResponse) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
 	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovTx(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &types.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinSelfDelegation = &v
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalDelegation = &v
+			if err := m.MaxTotalDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1395,17 +2794,17 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1415,30 +2814,29 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1448,30 +2846,29 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1481,27 +2878,126 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
@@ -1533,9 +3029,9 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1563,13 +3059,13 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1579,31 +3075,27 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &types.Any{}
-			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1630,7 +3122,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1655,7 +3147,7 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1678,12 +3170,45 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1705,7 +3230,7 @@ func (m *MsgCreateValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginRedelegatePercent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1728,17 +3253,17 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginRedelegatePercent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginRedelegatePercent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1748,28 +3273,27 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1797,11 +3321,11 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1829,15 +3353,11 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CommissionRate = &v
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1865,9 +3385,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.MinSelfDelegation = &v
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Percent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1892,7 +3410,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginRedelegatePercentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1915,12 +3433,78 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginRedelegatePercentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginRedelegatePercentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1942,7 +3526,7 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1965,10 +3549,10 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2089,7 +3673,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2112,12 +3696,45 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2139,7 +3756,7 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2162,10 +3779,10 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2202,7 +3819,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2230,11 +3847,11 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2262,38 +3879,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2318,7 +3904,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2341,10 +3927,10 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2380,6 +3966,39 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2401,7 +4020,7 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2424,10 +4043,10 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2527,6 +4146,25 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2548,7 +4186,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2571,45 +4209,12 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -46,17 +46,18 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 	}
 
 	return Validator{
-		OperatorAddress:   operator.String(),
-		ConsensusPubkey:   pkAny,
-		Jailed:            false,
-		Status:            Unbonded,
-		Tokens:            sdk.ZeroInt(),
-		DelegatorShares:   sdk.ZeroDec(),
-		Description:       description,
-		UnbondingHeight:   int64(0),
-		UnbondingTime:     time.Unix(0, 0).UTC(),
-		Commission:        NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
-		MinSelfDelegation: sdk.OneInt(),
+		OperatorAddress:    operator.String(),
+		ConsensusPubkey:    pkAny,
+		Jailed:             false,
+		Status:             Unbonded,
+		Tokens:             sdk.ZeroInt(),
+		DelegatorShares:    sdk.ZeroDec(),
+		Description:        description,
+		UnbondingHeight:    int64(0),
+		UnbondingTime:      time.Unix(0, 0).UTC(),
+		Commission:         NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation:  sdk.OneInt(),
+		MaxTotalDelegation: sdk.ZeroInt(),
 	}, nil
 }
 
@@ -309,6 +310,17 @@ func (v Validator) InvalidExRate() bool {
 	return v.Tokens.IsZero() && v.DelegatorShares.IsPositive()
 }
 
+// ExceedsMaxTotalDelegation returns true if delegating the given amount of
+// tokens would take the validator above its maximum total delegation. A zero
+// or unset maximum never limits the delegated tokens.
+func (v Validator) ExceedsMaxTotalDelegation(amount sdk.Int) bool {
+	if v.MaxTotalDelegation.IsNil() || v.MaxTotalDelegation.IsZero() {
+		return false
+	}
+
+	return v.Tokens.Add(amount).GT(v.MaxTotalDelegation)
+}
+
 // calculate the token worth of provided shares
 func (v Validator) TokensFromShares(shares sdk.Dec) sdk.Dec {
 	return (shares.MulInt(v.Tokens)).Quo(v.DelegatorShares)
@@ -451,6 +463,7 @@ func (v *Validator) MinEqual(other *Validator) bool {
 		v.Commission.Equal(other.Commission) &&
 		v.Jailed == other.Jailed &&
 		v.MinSelfDelegation.Equal(other.MinSelfDelegation) &&
+		v.MaxTotalDelegation.Equal(other.MaxTotalDelegation) &&
 		v.ConsensusPubkey.Equal(other.ConsensusPubkey)
 
 }